@@ -146,12 +146,16 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
+			if isMockEnabled() {
+				mockProviderConfigure(primary)
+			}
+
 			return providerServerFactory(), nil
 		}
 	}
@@ -297,10 +301,15 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		if isMockEnabled() {
+			// Credentials are not required when testing against the fake AWS backend.
+			mockProviderConfigure(Provider)
+		} else {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
-		if os.Getenv(envvar.AccessKeyId) != "" {
-			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			if os.Getenv(envvar.AccessKeyId) != "" {
+				envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			}
 		}
 
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
@@ -3,7 +3,26 @@
 
 package acctest
 
+import (
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder      = closeVCRRecorder
+	NewVCRRedactor        = newVCRRedactor
+	VCRCassetteFindings   = vcrCassetteFindings
+	VCRRequestBodiesMatch = vcrRequestBodiesMatch
 )
+
+func (r *vcrRedactor) SetAccountID(accountID string) {
+	r.setAccountID(accountID)
+}
+
+func (r *vcrRedactor) StripHeaders(i *cassette.Interaction) error {
+	return r.stripHeaders(i)
+}
+
+func (r *vcrRedactor) Redact(s string) string {
+	return r.redact(s)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/mockaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	// mockServer is the fake AWS backend shared by all provider instances in the test binary.
+	// It is started on first use and runs until the test binary exits.
+	mockServer     *mockaws.Server
+	mockServerOnce sync.Once
+)
+
+func isMockEnabled() bool {
+	v, _ := strconv.ParseBool(os.Getenv(envvar.AccMockAWS))
+	return v
+}
+
+// mockEndpoints returns a provider `endpoints` configuration block that routes every service to the fake AWS backend.
+// Services that the fake AWS backend doesn't implement return a `NotImplemented` error.
+func mockEndpoints(provider *schema.Provider) map[string]interface{} {
+	mockServerOnce.Do(func() {
+		mockServer = mockaws.NewServer(mockaws.DefaultServices()...)
+	})
+
+	// Only set each service's primary key; setting more than one alias of a service is an error.
+	attributes := provider.Schema["endpoints"].Elem.(*schema.Resource).Schema
+	endpoints := make(map[string]interface{})
+	for _, k := range names.ProviderPackages() {
+		if _, ok := attributes[k]; ok {
+			endpoints[k] = mockServer.URL()
+		}
+	}
+	for k, v := range mockServer.Endpoints() {
+		endpoints[k] = v
+	}
+
+	return endpoints
+}
+
+// mockProviderConfigure replaces the provider's configuration function with one that points the provider at the fake AWS backend.
+// Credentials and service endpoints in the provider configuration are overridden.
+func mockProviderConfigure(provider *schema.Provider) {
+	configureContextFunc := provider.ConfigureContextFunc
+
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		for k, v := range map[string]interface{}{
+			"access_key":              mockaws.AccessKeyID,
+			"endpoints":               []interface{}{mockEndpoints(provider)},
+			"s3_use_path_style":       true,
+			"secret_key":              mockaws.SecretAccessKey,
+			"skip_metadata_api_check": "true",
+		} {
+			if err := d.Set(k, v); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "configuring provider for fake AWS backend: setting %s: %s", k, err)
+			}
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
@@ -0,0 +1,610 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type dynamoDBService struct {
+	jsonHandler
+
+	mu     sync.Mutex
+	tables map[string]*dynamoDBTable // Keyed by Region and table name.
+}
+
+type dynamoDBTable struct {
+	// description is the table's TableDescription, kept in its wire format.
+	description map[string]any
+
+	pointInTimeRecoveryEnabled bool
+	tags                       map[string]string
+	timeToLive                 map[string]any
+}
+
+// NewDynamoDBService returns a fake Amazon DynamoDB API.
+// Only table management operations are supported.
+// Table and index changes take effect immediately.
+func NewDynamoDBService() Service {
+	s := &dynamoDBService{
+		tables: make(map[string]*dynamoDBTable),
+	}
+	s.jsonHandler = jsonHandler{
+		targetPrefix: "DynamoDB_20120810",
+		operations: map[string]jsonOperation{
+			"CreateTable":               s.createTable,
+			"DeleteTable":               s.deleteTable,
+			"DescribeContinuousBackups": s.describeContinuousBackups,
+			"DescribeTable":             s.describeTable,
+			"DescribeTimeToLive":        s.describeTimeToLive,
+			"ListTables":                s.listTables,
+			"ListTagsOfResource":        s.listTagsOfResource,
+			"TagResource":               s.tagResource,
+			"UntagResource":             s.untagResource,
+			"UpdateContinuousBackups":   s.updateContinuousBackups,
+			"UpdateTable":               s.updateTable,
+			"UpdateTimeToLive":          s.updateTimeToLive,
+		},
+	}
+
+	return s
+}
+
+func (s *dynamoDBService) EndpointKey() string {
+	return names.DynamoDB
+}
+
+func (s *dynamoDBService) SigningName() string {
+	return "dynamodb"
+}
+
+func dynamoDBResourceNotFoundError(name string) *apiError {
+	return newAPIError(http.StatusBadRequest, "ResourceNotFoundException", "Requested resource not found: Table: %s not found", name)
+}
+
+// findTable returns the table with the specified name.
+// The caller must hold s.mu.
+func (s *dynamoDBService) findTable(ctx context.Context, name string) (*dynamoDBTable, error) {
+	table, ok := s.tables[regionalKey(ctx, name)]
+	if !ok {
+		return nil, dynamoDBResourceNotFoundError(name)
+	}
+
+	return table, nil
+}
+
+// findTableByARN returns the table with the specified ARN.
+// The caller must hold s.mu.
+func (s *dynamoDBService) findTableByARN(arn string) (*dynamoDBTable, error) {
+	for _, table := range s.tables {
+		if table.description["TableArn"] == arn {
+			return table, nil
+		}
+	}
+
+	return nil, newAPIError(http.StatusBadRequest, "ResourceNotFoundException", "Requested resource not found: ResourcArn: %s not found", arn)
+}
+
+type dynamoDBTag struct {
+	Key   string
+	Value string
+}
+
+func dynamoDBProvisionedThroughput(v any) map[string]any {
+	throughput := map[string]any{
+		"NumberOfDecreasesToday": 0,
+		"ReadCapacityUnits":      0,
+		"WriteCapacityUnits":     0,
+	}
+
+	if v, ok := v.(map[string]any); ok {
+		maps.Copy(throughput, v)
+	}
+
+	return throughput
+}
+
+func dynamoDBGlobalSecondaryIndex(tableARN string, v map[string]any) map[string]any {
+	index := maps.Clone(v)
+	index["IndexArn"] = fmt.Sprintf("%s/index/%s", tableARN, v["IndexName"])
+	index["IndexSizeBytes"] = 0
+	index["IndexStatus"] = "ACTIVE"
+	index["ItemCount"] = 0
+	index["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(v["ProvisionedThroughput"])
+
+	return index
+}
+
+func dynamoDBSSEDescription(ctx context.Context, v any) map[string]any {
+	if v, ok := v.(map[string]any); ok && v["Enabled"] == true {
+		keyARN, ok := v["KMSMasterKeyId"].(string)
+		if !ok || keyARN == "" {
+			keyARN = regionalARN(ctx, "kms", "key/00000000-0000-0000-0000-000000000000")
+		}
+
+		return map[string]any{
+			"KMSMasterKeyArn": keyARN,
+			"SSEType":         "KMS",
+			"Status":          "ENABLED",
+		}
+	}
+
+	return nil
+}
+
+// describe returns a deep copy of the table's TableDescription that is safe to use without holding the service lock.
+func (t *dynamoDBTable) describe() map[string]any {
+	var description map[string]any
+
+	b, _ := json.Marshal(t.description)
+	json.Unmarshal(b, &description) //nolint:errcheck // Round-tripping a value produced by json.Marshal cannot fail
+
+	return description
+}
+
+// setStreamSpecification updates the table's stream configuration.
+func (t *dynamoDBTable) setStreamSpecification(v any) {
+	if v, ok := v.(map[string]any); ok && v["StreamEnabled"] == true {
+		label := time.Now().UTC().Format("2006-01-02T15:04:05.000")
+		t.description["LatestStreamArn"] = fmt.Sprintf("%s/stream/%s", t.description["TableArn"], label)
+		t.description["LatestStreamLabel"] = label
+		t.description["StreamSpecification"] = v
+	} else {
+		delete(t.description, "StreamSpecification")
+	}
+}
+
+func (s *dynamoDBService) createTable(ctx context.Context, input json.RawMessage) (any, error) {
+	var in map[string]any
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	name, ok := in["TableName"].(string)
+	if !ok || name == "" {
+		return nil, newValidationError("TableName must be specified")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := regionalKey(ctx, name)
+	if _, ok := s.tables[key]; ok {
+		return nil, newAPIError(http.StatusBadRequest, "ResourceInUseException", "Table already exists: %s", name)
+	}
+
+	arn := regionalARN(ctx, "dynamodb", "table/"+name)
+	description := map[string]any{
+		"AttributeDefinitions":      in["AttributeDefinitions"],
+		"CreationDateTime":          float64(time.Now().Unix()),
+		"DeletionProtectionEnabled": in["DeletionProtectionEnabled"] == true,
+		"ItemCount":                 0,
+		"KeySchema":                 in["KeySchema"],
+		"ProvisionedThroughput":     dynamoDBProvisionedThroughput(in["ProvisionedThroughput"]),
+		"TableArn":                  arn,
+		"TableId":                   fmt.Sprintf("00000000-0000-0000-0000-%012d", len(s.tables)+1),
+		"TableName":                 name,
+		"TableSizeBytes":            0,
+		"TableStatus":               "ACTIVE",
+	}
+	if v, ok := in["BillingMode"].(string); ok {
+		description["BillingModeSummary"] = map[string]any{
+			"BillingMode": v,
+		}
+	}
+	if v, ok := in["GlobalSecondaryIndexes"].([]any); ok {
+		var indexes []any
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				indexes = append(indexes, dynamoDBGlobalSecondaryIndex(arn, v))
+			}
+		}
+		description["GlobalSecondaryIndexes"] = indexes
+	}
+	if v, ok := in["LocalSecondaryIndexes"].([]any); ok {
+		var indexes []any
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				index := maps.Clone(v)
+				index["IndexArn"] = fmt.Sprintf("%s/index/%s", arn, v["IndexName"])
+				indexes = append(indexes, index)
+			}
+		}
+		description["LocalSecondaryIndexes"] = indexes
+	}
+	if v := in["OnDemandThroughput"]; v != nil {
+		description["OnDemandThroughput"] = v
+	}
+	if v := dynamoDBSSEDescription(ctx, in["SSESpecification"]); v != nil {
+		description["SSEDescription"] = v
+	}
+	if v, ok := in["TableClass"].(string); ok {
+		description["TableClassSummary"] = map[string]any{
+			"TableClass": v,
+		}
+	}
+
+	table := &dynamoDBTable{
+		description: description,
+		tags:        make(map[string]string),
+		timeToLive: map[string]any{
+			"TimeToLiveStatus": "DISABLED",
+		},
+	}
+	table.setStreamSpecification(in["StreamSpecification"])
+
+	if v, ok := in["Tags"].([]any); ok {
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				table.tags[fmt.Sprint(v["Key"])] = fmt.Sprint(v["Value"])
+			}
+		}
+	}
+
+	s.tables[key] = table
+
+	return map[string]any{
+		"TableDescription": table.describe(),
+	}, nil
+}
+
+func (s *dynamoDBService) deleteTable(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		TableName string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.findTable(ctx, in.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if table.description["DeletionProtectionEnabled"] == true {
+		return nil, newValidationError("Resource cannot be deleted as it is currently protected against deletion. Disable deletion protection first.")
+	}
+
+	delete(s.tables, regionalKey(ctx, in.TableName))
+
+	description := table.describe()
+	description["TableStatus"] = "DELETING"
+
+	return map[string]any{
+		"TableDescription": description,
+	}, nil
+}
+
+func (s *dynamoDBService) describeContinuousBackups(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		TableName string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.findTable(ctx, in.TableName)
+	if err != nil {
+		return nil, newAPIError(http.StatusBadRequest, "TableNotFoundException", "Table not found: %s", in.TableName)
+	}
+
+	status := "DISABLED"
+	if table.pointInTimeRecoveryEnabled {
+		status = "ENABLED"
+	}
+
+	return map[string]any{
+		"ContinuousBackupsDescription": map[string]any{
+			"ContinuousBackupsStatus": "ENABLED",
+			"PointInTimeRecoveryDescription": map[string]any{
+				"PointInTimeRecoveryStatus": status,
+			},
+		},
+	}, nil
+}
+
+func (s *dynamoDBService) describeTable(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		TableName string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.findTable(ctx, in.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"Table": table.describe(),
+	}, nil
+}
+
+func (s *dynamoDBService) describeTimeToLive(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		TableName string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.findTable(ctx, in.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"TimeToLiveDescription": table.timeToLive,
+	}, nil
+}
+
+func (s *dynamoDBService) listTables(ctx context.Context, _ json.RawMessage) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tableNames := []string{}
+	for key, table := range s.tables {
+		if name := table.description["TableName"].(string); key == regionalKey(ctx, name) {
+			tableNames = append(tableNames, name)
+		}
+	}
+	slices.Sort(tableNames)
+
+	return map[string]any{
+		"TableNames": tableNames,
+	}, nil
+}
+
+func (s *dynamoDBService) listTagsOfResource(_ context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		ResourceARN string `json:"ResourceArn"`
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.findTableByARN(in.ResourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []dynamoDBTag{}
+	for _, k := range slices.Sorted(maps.Keys(table.tags)) {
+		tags = append(tags, dynamoDBTag{Key: k, Value: table.tags[k]})
+	}
+
+	return map[string]any{
+		"Tags": tags,
+	}, nil
+}
+
+func (s *dynamoDBService) tagResource(_ context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		ResourceARN string `json:"ResourceArn"`
+		Tags        []dynamoDBTag
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.findTableByARN(in.ResourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range in.Tags {
+		table.tags[tag.Key] = tag.Value
+	}
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) untagResource(_ context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		ResourceARN string `json:"ResourceArn"`
+		TagKeys     []string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.findTableByARN(in.ResourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range in.TagKeys {
+		delete(table.tags, k)
+	}
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) updateContinuousBackups(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		PointInTimeRecoverySpecification struct {
+			PointInTimeRecoveryEnabled bool
+		}
+		TableName string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	table, err := s.findTable(ctx, in.TableName)
+	if err == nil {
+		table.pointInTimeRecoveryEnabled = in.PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		return nil, newAPIError(http.StatusBadRequest, "TableNotFoundException", "Table not found: %s", in.TableName)
+	}
+
+	return s.describeContinuousBackups(ctx, input)
+}
+
+func (s *dynamoDBService) updateTable(ctx context.Context, input json.RawMessage) (any, error) {
+	var in map[string]any
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	name, _ := in["TableName"].(string)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.findTable(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	description := table.description
+	if v := in["AttributeDefinitions"]; v != nil {
+		description["AttributeDefinitions"] = v
+	}
+	if v, ok := in["BillingMode"].(string); ok {
+		description["BillingModeSummary"] = map[string]any{
+			"BillingMode": v,
+		}
+		if v == "PAY_PER_REQUEST" {
+			description["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(nil)
+		}
+	}
+	if v, ok := in["DeletionProtectionEnabled"].(bool); ok {
+		description["DeletionProtectionEnabled"] = v
+	}
+	if v := in["OnDemandThroughput"]; v != nil {
+		description["OnDemandThroughput"] = v
+	}
+	if v := in["ProvisionedThroughput"]; v != nil {
+		description["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(v)
+	}
+	if v := in["SSESpecification"]; v != nil {
+		if v := dynamoDBSSEDescription(ctx, v); v != nil {
+			description["SSEDescription"] = v
+		} else {
+			delete(description, "SSEDescription")
+		}
+	}
+	if v := in["StreamSpecification"]; v != nil {
+		table.setStreamSpecification(v)
+	}
+	if v, ok := in["TableClass"].(string); ok {
+		description["TableClassSummary"] = map[string]any{
+			"TableClass": v,
+		}
+	}
+
+	if updates, ok := in["GlobalSecondaryIndexUpdates"].([]any); ok {
+		indexes, _ := description["GlobalSecondaryIndexes"].([]any)
+
+		for _, update := range updates {
+			update, ok := update.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if v, ok := update["Create"].(map[string]any); ok {
+				indexes = append(indexes, dynamoDBGlobalSecondaryIndex(description["TableArn"].(string), v))
+			}
+
+			if v, ok := update["Update"].(map[string]any); ok {
+				for _, index := range indexes {
+					if index := index.(map[string]any); index["IndexName"] == v["IndexName"] {
+						index["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(v["ProvisionedThroughput"])
+						if v := v["OnDemandThroughput"]; v != nil {
+							index["OnDemandThroughput"] = v
+						}
+					}
+				}
+			}
+
+			if v, ok := update["Delete"].(map[string]any); ok {
+				indexes = slices.DeleteFunc(indexes, func(index any) bool {
+					return index.(map[string]any)["IndexName"] == v["IndexName"]
+				})
+			}
+		}
+
+		if len(indexes) > 0 {
+			description["GlobalSecondaryIndexes"] = indexes
+		} else {
+			delete(description, "GlobalSecondaryIndexes")
+		}
+	}
+
+	return map[string]any{
+		"TableDescription": table.describe(),
+	}, nil
+}
+
+func (s *dynamoDBService) updateTimeToLive(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		TableName               string
+		TimeToLiveSpecification struct {
+			AttributeName string
+			Enabled       bool
+		}
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.findTable(ctx, in.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.TimeToLiveSpecification.Enabled {
+		table.timeToLive = map[string]any{
+			"AttributeName":    in.TimeToLiveSpecification.AttributeName,
+			"TimeToLiveStatus": "ENABLED",
+		}
+	} else {
+		table.timeToLive = map[string]any{
+			"TimeToLiveStatus": "DISABLED",
+		}
+	}
+
+	return map[string]any{
+		"TimeToLiveSpecification": in.TimeToLiveSpecification,
+	}, nil
+}
@@ -0,0 +1,623 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type iamService struct {
+	queryHandler
+
+	mu    sync.Mutex
+	roles map[string]*iamRole // Keyed by role name.
+
+	idCounter atomic.Uint64
+}
+
+type iamRole struct {
+	arn                      string
+	assumeRolePolicyDocument string
+	attachedPolicyARNs       []string
+	createDate               time.Time
+	description              string
+	inlinePolicies           map[string]string
+	maxSessionDuration       int
+	path                     string
+	permissionsBoundary      string
+	roleID                   string
+	roleName                 string
+	tags                     map[string]string
+}
+
+// NewIAMService returns a fake AWS Identity and Access Management (IAM) API.
+// Roles, and the inline and managed policies attached to them, are supported.
+func NewIAMService() Service {
+	s := &iamService{
+		roles: make(map[string]*iamRole),
+	}
+	s.queryHandler = queryHandler{
+		xmlns: "https://iam.amazonaws.com/doc/2010-05-08/",
+		operations: map[string]queryOperation{
+			"AttachRolePolicy":              s.attachRolePolicy,
+			"CreateRole":                    s.createRole,
+			"DeleteRole":                    s.deleteRole,
+			"DeleteRolePermissionsBoundary": s.deleteRolePermissionsBoundary,
+			"DeleteRolePolicy":              s.deleteRolePolicy,
+			"DetachRolePolicy":              s.detachRolePolicy,
+			"GetRole":                       s.getRole,
+			"GetRolePolicy":                 s.getRolePolicy,
+			"ListAttachedRolePolicies":      s.listAttachedRolePolicies,
+			"ListInstanceProfilesForRole":   s.listInstanceProfilesForRole,
+			"ListRolePolicies":              s.listRolePolicies,
+			"ListRoles":                     s.listRoles,
+			"ListRoleTags":                  s.listRoleTags,
+			"PutRolePermissionsBoundary":    s.putRolePermissionsBoundary,
+			"PutRolePolicy":                 s.putRolePolicy,
+			"TagRole":                       s.tagRole,
+			"UntagRole":                     s.untagRole,
+			"UpdateAssumeRolePolicy":        s.updateAssumeRolePolicy,
+			"UpdateRole":                    s.updateRole,
+			"UpdateRoleDescription":         s.updateRoleDescription,
+		},
+	}
+
+	return s
+}
+
+func (s *iamService) EndpointKey() string {
+	return names.IAM
+}
+
+func (s *iamService) SigningName() string {
+	return "iam"
+}
+
+func iamNoSuchEntityError(entity, name string) *apiError {
+	return newAPIError(http.StatusNotFound, "NoSuchEntity", "The %s with name %s cannot be found.", entity, name)
+}
+
+func iamValidatePolicyDocument(document string) error {
+	if !json.Valid([]byte(document)) {
+		return newAPIError(http.StatusBadRequest, "MalformedPolicyDocument", "This policy contains invalid Json")
+	}
+
+	return nil
+}
+
+// findRole returns the role with the name specified in the request.
+// The caller must hold s.mu.
+func (s *iamService) findRole(input url.Values) (*iamRole, error) {
+	name, err := requiredQueryParameter(input, "RoleName")
+	if err != nil {
+		return nil, err
+	}
+
+	role, ok := s.roles[name]
+	if !ok {
+		return nil, iamNoSuchEntityError("role", name)
+	}
+
+	return role, nil
+}
+
+type iamTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+func iamTags(m map[string]string) []iamTag {
+	var tags []iamTag
+
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		tags = append(tags, iamTag{Key: k, Value: m[k]})
+	}
+
+	return tags
+}
+
+type iamPermissionsBoundary struct {
+	PermissionsBoundaryARN  string `xml:"PermissionsBoundaryArn"`
+	PermissionsBoundaryType string `xml:"PermissionsBoundaryType"`
+}
+
+type iamRoleOutput struct {
+	ARN                      string                  `xml:"Arn"`
+	AssumeRolePolicyDocument string                  `xml:"AssumeRolePolicyDocument"`
+	CreateDate               time.Time               `xml:"CreateDate"`
+	Description              string                  `xml:"Description,omitempty"`
+	MaxSessionDuration       int                     `xml:"MaxSessionDuration"`
+	Path                     string                  `xml:"Path"`
+	PermissionsBoundary      *iamPermissionsBoundary `xml:"PermissionsBoundary,omitempty"`
+	RoleID                   string                  `xml:"RoleId"`
+	RoleName                 string                  `xml:"RoleName"`
+	Tags                     []iamTag                `xml:"Tags>member,omitempty"`
+}
+
+func (r *iamRole) output(withTags bool) *iamRoleOutput {
+	output := &iamRoleOutput{
+		ARN:                      r.arn,
+		AssumeRolePolicyDocument: url.QueryEscape(r.assumeRolePolicyDocument), // Policy documents are returned URL-encoded.
+		CreateDate:               r.createDate,
+		Description:              r.description,
+		MaxSessionDuration:       r.maxSessionDuration,
+		Path:                     r.path,
+		RoleID:                   r.roleID,
+		RoleName:                 r.roleName,
+	}
+
+	if r.permissionsBoundary != "" {
+		output.PermissionsBoundary = &iamPermissionsBoundary{
+			PermissionsBoundaryARN:  r.permissionsBoundary,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	if withTags {
+		output.Tags = iamTags(r.tags)
+	}
+
+	return output
+}
+
+func (s *iamService) attachRolePolicy(_ context.Context, input url.Values) (any, error) {
+	policyARN, err := requiredQueryParameter(input, "PolicyArn")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(role.attachedPolicyARNs, policyARN) {
+		role.attachedPolicyARNs = append(role.attachedPolicyARNs, policyARN)
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) createRole(_ context.Context, input url.Values) (any, error) {
+	name, err := requiredQueryParameter(input, "RoleName")
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := requiredQueryParameter(input, "AssumeRolePolicyDocument")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iamValidatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	path := input.Get("Path")
+	if path == "" {
+		path = "/"
+	}
+
+	maxSessionDuration := 3600
+	if v := input.Get("MaxSessionDuration"); v != "" {
+		maxSessionDuration, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, newValidationError("Invalid MaxSessionDuration: %s", v)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.roles[name]; ok {
+		return nil, newAPIError(http.StatusConflict, "EntityAlreadyExists", "Role with name %s already exists.", name)
+	}
+
+	role := &iamRole{
+		arn:                      globalARN("iam", "role"+path+name),
+		assumeRolePolicyDocument: document,
+		createDate:               time.Now().UTC().Truncate(time.Second),
+		description:              input.Get("Description"),
+		inlinePolicies:           make(map[string]string),
+		maxSessionDuration:       maxSessionDuration,
+		path:                     path,
+		permissionsBoundary:      input.Get("PermissionsBoundary"),
+		roleID:                   fmt.Sprintf("AROA%017d", s.idCounter.Add(1)),
+		roleName:                 name,
+		tags:                     queryStringMap(input, "Tags.member", "Key", "Value"),
+	}
+	s.roles[name] = role
+
+	return struct {
+		Role *iamRoleOutput `xml:"Role"`
+	}{
+		Role: role.output(true),
+	}, nil
+}
+
+func (s *iamService) deleteRole(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(role.attachedPolicyARNs) > 0 || len(role.inlinePolicies) > 0 {
+		return nil, newAPIError(http.StatusConflict, "DeleteConflict", "Cannot delete entity, must detach all policies first.")
+	}
+
+	delete(s.roles, role.roleName)
+
+	return nil, nil
+}
+
+func (s *iamService) deleteRolePermissionsBoundary(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	role.permissionsBoundary = ""
+
+	return nil, nil
+}
+
+func (s *iamService) deleteRolePolicy(_ context.Context, input url.Values) (any, error) {
+	policyName, err := requiredQueryParameter(input, "PolicyName")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := role.inlinePolicies[policyName]; !ok {
+		return nil, iamNoSuchEntityError("role policy", policyName)
+	}
+
+	delete(role.inlinePolicies, policyName)
+
+	return nil, nil
+}
+
+func (s *iamService) detachRolePolicy(_ context.Context, input url.Values) (any, error) {
+	policyARN, err := requiredQueryParameter(input, "PolicyArn")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.Index(role.attachedPolicyARNs, policyARN)
+	if i == -1 {
+		return nil, newAPIError(http.StatusNotFound, "NoSuchEntity", "Policy %s was not found.", policyARN)
+	}
+
+	role.attachedPolicyARNs = slices.Delete(role.attachedPolicyARNs, i, i+1)
+
+	return nil, nil
+}
+
+func (s *iamService) getRole(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Role *iamRoleOutput `xml:"Role"`
+	}{
+		Role: role.output(true),
+	}, nil
+}
+
+func (s *iamService) getRolePolicy(_ context.Context, input url.Values) (any, error) {
+	policyName, err := requiredQueryParameter(input, "PolicyName")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	document, ok := role.inlinePolicies[policyName]
+	if !ok {
+		return nil, iamNoSuchEntityError("role policy", policyName)
+	}
+
+	return struct {
+		PolicyDocument string `xml:"PolicyDocument"`
+		PolicyName     string `xml:"PolicyName"`
+		RoleName       string `xml:"RoleName"`
+	}{
+		PolicyDocument: url.QueryEscape(document),
+		PolicyName:     policyName,
+		RoleName:       role.roleName,
+	}, nil
+}
+
+func (s *iamService) listAttachedRolePolicies(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	type attachedPolicy struct {
+		PolicyARN  string `xml:"PolicyArn"`
+		PolicyName string `xml:"PolicyName"`
+	}
+	var policies []attachedPolicy
+	for _, arn := range role.attachedPolicyARNs {
+		policies = append(policies, attachedPolicy{
+			PolicyARN:  arn,
+			PolicyName: arn[strings.LastIndex(arn, "/")+1:],
+		})
+	}
+
+	return struct {
+		AttachedPolicies []attachedPolicy `xml:"AttachedPolicies>member"`
+		IsTruncated      bool             `xml:"IsTruncated"`
+	}{
+		AttachedPolicies: policies,
+	}, nil
+}
+
+func (s *iamService) listInstanceProfilesForRole(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.findRole(input); err != nil {
+		return nil, err
+	}
+
+	// Instance profiles are not supported.
+	return struct {
+		InstanceProfiles []struct{} `xml:"InstanceProfiles>member"`
+		IsTruncated      bool       `xml:"IsTruncated"`
+	}{}, nil
+}
+
+func (s *iamService) listRolePolicies(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		IsTruncated bool     `xml:"IsTruncated"`
+		PolicyNames []string `xml:"PolicyNames>member"`
+	}{
+		PolicyNames: slices.Sorted(maps.Keys(role.inlinePolicies)),
+	}, nil
+}
+
+func (s *iamService) listRoles(_ context.Context, input url.Values) (any, error) {
+	pathPrefix := input.Get("PathPrefix")
+	if pathPrefix == "" {
+		pathPrefix = "/"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var roles []*iamRoleOutput
+	for _, name := range slices.Sorted(maps.Keys(s.roles)) {
+		if role := s.roles[name]; strings.HasPrefix(role.path, pathPrefix) {
+			// ListRoles does not return tags.
+			roles = append(roles, role.output(false))
+		}
+	}
+
+	return struct {
+		IsTruncated bool             `xml:"IsTruncated"`
+		Roles       []*iamRoleOutput `xml:"Roles>member"`
+	}{
+		Roles: roles,
+	}, nil
+}
+
+func (s *iamService) listRoleTags(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		IsTruncated bool     `xml:"IsTruncated"`
+		Tags        []iamTag `xml:"Tags>member"`
+	}{
+		Tags: iamTags(role.tags),
+	}, nil
+}
+
+func (s *iamService) putRolePermissionsBoundary(_ context.Context, input url.Values) (any, error) {
+	boundary, err := requiredQueryParameter(input, "PermissionsBoundary")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	role.permissionsBoundary = boundary
+
+	return nil, nil
+}
+
+func (s *iamService) putRolePolicy(_ context.Context, input url.Values) (any, error) {
+	policyName, err := requiredQueryParameter(input, "PolicyName")
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := requiredQueryParameter(input, "PolicyDocument")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iamValidatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	role.inlinePolicies[policyName] = document
+
+	return nil, nil
+}
+
+func (s *iamService) tagRole(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	maps.Copy(role.tags, queryStringMap(input, "Tags.member", "Key", "Value"))
+
+	return nil, nil
+}
+
+func (s *iamService) untagRole(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range queryStringList(input, "TagKeys.member") {
+		delete(role.tags, k)
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) updateAssumeRolePolicy(_ context.Context, input url.Values) (any, error) {
+	document, err := requiredQueryParameter(input, "PolicyDocument")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iamValidatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	role.assumeRolePolicyDocument = document
+
+	return nil, nil
+}
+
+func (s *iamService) updateRole(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Has("Description") {
+		role.description = input.Get("Description")
+	}
+
+	if v := input.Get("MaxSessionDuration"); v != "" {
+		maxSessionDuration, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newValidationError("Invalid MaxSessionDuration: %s", v)
+		}
+		role.maxSessionDuration = maxSessionDuration
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) updateRoleDescription(_ context.Context, input url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.findRole(input)
+	if err != nil {
+		return nil, err
+	}
+
+	role.description = input.Get("Description")
+
+	return struct {
+		Role *iamRoleOutput `xml:"Role"`
+	}{
+		Role: role.output(true),
+	}, nil
+}
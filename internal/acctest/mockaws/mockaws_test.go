@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/mockaws"
+)
+
+func newTestServer(t *testing.T) (*mockaws.Server, aws.Config) {
+	t.Helper()
+
+	server := mockaws.NewServer(mockaws.DefaultServices()...)
+	t.Cleanup(server.Close)
+
+	cfg := aws.Config{
+		Credentials:      credentials.NewStaticCredentialsProvider(mockaws.AccessKeyID, mockaws.SecretAccessKey, ""),
+		BaseEndpoint:     aws.String(server.URL()),
+		Region:           "us-west-2", //lintignore:AWSAT003
+		RetryMaxAttempts: 1,
+	}
+
+	return server, cfg
+}
+
+func errorCode(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+
+	return ""
+}
+
+func TestServerEndpoints(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newTestServer(t)
+
+	endpoints := server.Endpoints()
+	for _, key := range []string{"dynamodb", "iam", "s3", "sns", "sqs", "ssm", "sts"} {
+		if got, want := endpoints[key], server.URL(); got != want {
+			t.Errorf("endpoint for %s = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestSTS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, cfg := newTestServer(t)
+	conn := sts.NewFromConfig(cfg)
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Account), mockaws.AccountID; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, cfg := newTestServer(t)
+	conn := sqs.NewFromConfig(cfg)
+
+	createOutput, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName: aws.String("test"),
+		Tags:      map[string]string{"key1": "value1"},
+	})
+	if err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+
+	queueURL := aws.ToString(createOutput.QueueUrl)
+
+	attributesOutput, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+		QueueUrl:       aws.String(queueURL),
+	})
+	if err != nil {
+		t.Fatalf("GetQueueAttributes: %s", err)
+	}
+
+	if got, want := attributesOutput.Attributes[string(sqstypes.QueueAttributeNameVisibilityTimeout)], "30"; got != want {
+		t.Errorf("VisibilityTimeout = %q, want %q", got, want)
+	}
+
+	tagsOutput, err := conn.ListQueueTags(ctx, &sqs.ListQueueTagsInput{
+		QueueUrl: aws.String(queueURL),
+	})
+	if err != nil {
+		t.Fatalf("ListQueueTags: %s", err)
+	}
+
+	if got, want := tagsOutput.Tags["key1"], "value1"; got != want {
+		t.Errorf("tag key1 = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)}); err != nil {
+		t.Fatalf("DeleteQueue: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: aws.String(queueURL),
+	})
+	if errs := new(sqstypes.QueueDoesNotExist); !errors.As(err, &errs) {
+		t.Errorf("GetQueueAttributes after DeleteQueue: got %v, want QueueDoesNotExist", err)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, cfg := newTestServer(t)
+	conn := sns.NewFromConfig(cfg)
+
+	createOutput, err := conn.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("CreateTopic: %s", err)
+	}
+
+	topicARN := aws.ToString(createOutput.TopicArn)
+	if want := "arn:aws:sns:us-west-2:123456789012:test"; topicARN != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("TopicArn = %q, want %q", topicARN, want)
+	}
+
+	if _, err := conn.SetTopicAttributes(ctx, &sns.SetTopicAttributesInput{
+		AttributeName:  aws.String("DisplayName"),
+		AttributeValue: aws.String("Test"),
+		TopicArn:       aws.String(topicARN),
+	}); err != nil {
+		t.Fatalf("SetTopicAttributes: %s", err)
+	}
+
+	attributesOutput, err := conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{
+		TopicArn: aws.String(topicARN),
+	})
+	if err != nil {
+		t.Fatalf("GetTopicAttributes: %s", err)
+	}
+
+	if got, want := attributesOutput.Attributes["DisplayName"], "Test"; got != want {
+		t.Errorf("DisplayName = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteTopic(ctx, &sns.DeleteTopicInput{TopicArn: aws.String(topicARN)}); err != nil {
+		t.Fatalf("DeleteTopic: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{
+		TopicArn: aws.String(topicARN),
+	})
+	if got, want := errorCode(err), "NotFound"; got != want {
+		t.Errorf("GetTopicAttributes after DeleteTopic: error code = %q, want %q", got, want)
+	}
+}
+
+func TestIAM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, cfg := newTestServer(t)
+	conn := iam.NewFromConfig(cfg)
+
+	const assumeRolePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	if _, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(assumeRolePolicy),
+		RoleName:                 aws.String("test"),
+		Tags: []iamtypes.Tag{
+			{Key: aws.String("key1"), Value: aws.String("value1")},
+		},
+	}); err != nil {
+		t.Fatalf("CreateRole: %s", err)
+	}
+
+	getOutput, err := conn.GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("GetRole: %s", err)
+	}
+
+	if got, want := aws.ToString(getOutput.Role.Arn), "arn:aws:iam::123456789012:role/test"; got != want { //lintignore:AWSAT005
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+	if got := len(getOutput.Role.Tags); got != 1 {
+		t.Errorf("len(Tags) = %d, want 1", got)
+	}
+
+	if _, err := conn.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+		PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`),
+		PolicyName:     aws.String("test"),
+		RoleName:       aws.String("test"),
+	}); err != nil {
+		t.Fatalf("PutRolePolicy: %s", err)
+	}
+
+	_, err = conn.DeleteRole(ctx, &iam.DeleteRoleInput{
+		RoleName: aws.String("test"),
+	})
+	if errs := new(iamtypes.DeleteConflictException); !errors.As(err, &errs) {
+		t.Errorf("DeleteRole with inline policy: got %v, want DeleteConflictException", err)
+	}
+
+	if _, err := conn.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
+		PolicyName: aws.String("test"),
+		RoleName:   aws.String("test"),
+	}); err != nil {
+		t.Fatalf("DeleteRolePolicy: %s", err)
+	}
+
+	if _, err := conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("DeleteRole: %s", err)
+	}
+
+	_, err = conn.GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String("test"),
+	})
+	if errs := new(iamtypes.NoSuchEntityException); !errors.As(err, &errs) {
+		t.Errorf("GetRole after DeleteRole: got %v, want NoSuchEntityException", err)
+	}
+}
+
+func TestSSM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, cfg := newTestServer(t)
+	conn := ssm.NewFromConfig(cfg)
+
+	for _, value := range []string{"value1", "value2"} {
+		if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+			Name:      aws.String("/test/parameter"),
+			Overwrite: aws.Bool(true),
+			Type:      ssmtypes.ParameterTypeSecureString,
+			Value:     aws.String(value),
+		}); err != nil {
+			t.Fatalf("PutParameter: %s", err)
+		}
+	}
+
+	getOutput, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+		Name:           aws.String("/test/parameter"),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("GetParameter: %s", err)
+	}
+
+	if got, want := aws.ToString(getOutput.Parameter.Value), "value2"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+	if got, want := getOutput.Parameter.Version, int64(2); got != want {
+		t.Errorf("Version = %d, want %d", got, want)
+	}
+
+	_, err = conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String("/test/parameter"),
+		Type:  ssmtypes.ParameterTypeString,
+		Value: aws.String("value3"),
+	})
+	if errs := new(ssmtypes.ParameterAlreadyExists); !errors.As(err, &errs) {
+		t.Errorf("PutParameter without Overwrite: got %v, want ParameterAlreadyExists", err)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: aws.String("/test/parameter")}); err != nil {
+		t.Fatalf("DeleteParameter: %s", err)
+	}
+
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{
+		Name: aws.String("/test/parameter"),
+	})
+	if errs := new(ssmtypes.ParameterNotFound); !errors.As(err, &errs) {
+		t.Errorf("GetParameter after DeleteParameter: got %v, want ParameterNotFound", err)
+	}
+}
+
+func TestDynamoDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, cfg := newTestServer(t)
+	conn := dynamodb.NewFromConfig(cfg)
+
+	if _, err := conn.CreateTable(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: []dynamodbtypes.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: dynamodbtypes.ScalarAttributeTypeS},
+		},
+		BillingMode: dynamodbtypes.BillingModePayPerRequest,
+		KeySchema: []dynamodbtypes.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: dynamodbtypes.KeyTypeHash},
+		},
+		TableName: aws.String("test"),
+	}); err != nil {
+		t.Fatalf("CreateTable: %s", err)
+	}
+
+	describeOutput, err := conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("DescribeTable: %s", err)
+	}
+
+	if got, want := describeOutput.Table.TableStatus, dynamodbtypes.TableStatusActive; got != want {
+		t.Errorf("TableStatus = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String("test")}); err != nil {
+		t.Fatalf("DeleteTable: %s", err)
+	}
+
+	_, err = conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String("test"),
+	})
+	if errs := new(dynamodbtypes.ResourceNotFoundException); !errors.As(err, &errs) {
+		t.Errorf("DescribeTable after DeleteTable: got %v, want ResourceNotFoundException", err)
+	}
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	_, cfg := newTestServer(t)
+	conn := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = true
+	})
+
+	if _, err := conn.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String("test"),
+		CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraintUsWest2,
+		},
+	}); err != nil {
+		t.Fatalf("CreateBucket: %s", err)
+	}
+
+	headOutput, err := conn.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("HeadBucket: %s", err)
+	}
+
+	if got, want := aws.ToString(headOutput.BucketRegion), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("BucketRegion = %q, want %q", got, want)
+	}
+
+	if _, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:   strings.NewReader("hello"),
+		Bucket: aws.String("test"),
+		Key:    aws.String("a/b"),
+	}); err != nil {
+		t.Fatalf("PutObject: %s", err)
+	}
+
+	getOutput, err := conn.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String("test"),
+		Key:    aws.String("a/b"),
+	})
+	if err != nil {
+		t.Fatalf("GetObject: %s", err)
+	}
+	defer getOutput.Body.Close()
+
+	body, err := io.ReadAll(getOutput.Body)
+	if err != nil {
+		t.Fatalf("reading object body: %s", err)
+	}
+
+	if got, want := string(body), "hello"; got != want {
+		t.Errorf("object body = %q, want %q", got, want)
+	}
+
+	_, err = conn.DeleteBucket(ctx, &s3.DeleteBucketInput{
+		Bucket: aws.String("test"),
+	})
+	if got, want := errorCode(err), "BucketNotEmpty"; got != want {
+		t.Errorf("DeleteBucket with object: error code = %q, want %q", got, want)
+	}
+
+	_, err = conn.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String("test"),
+	})
+	if got, want := errorCode(err), "NotImplemented"; got != want {
+		t.Errorf("GetBucketPolicy: error code = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String("test"), Key: aws.String("a/b")}); err != nil {
+		t.Fatalf("DeleteObject: %s", err)
+	}
+
+	if _, err := conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("DeleteBucket: %s", err)
+	}
+
+	_, err = conn.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String("test"),
+	})
+	if errs := new(s3types.NotFound); !errors.As(err, &errs) {
+		t.Errorf("HeadBucket after DeleteBucket: got %v, want NotFound", err)
+	}
+}
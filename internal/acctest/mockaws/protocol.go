@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// apiError is an error returned by a fake AWS service API.
+type apiError struct {
+	statusCode int
+	code       string
+	message    string
+
+	// queryCode is the error code reported to clients of AWS JSON services that support AWS Query compatible errors, e.g. SQS.
+	queryCode string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func newAPIError(statusCode int, code, format string, a ...any) *apiError {
+	return &apiError{
+		statusCode: statusCode,
+		code:       code,
+		message:    fmt.Sprintf(format, a...),
+	}
+}
+
+func newValidationError(format string, a ...any) *apiError {
+	return newAPIError(http.StatusBadRequest, "ValidationException", format, a...)
+}
+
+func asAPIError(err error) *apiError {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	return newAPIError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+}
+
+// writeRawError writes an error for a request that could not be routed to a service.
+func writeRawError(w http.ResponseWriter, statusCode int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, xmlEscape(message))
+}
+
+func xmlEscape(s string) string {
+	var sb strings.Builder
+	xml.EscapeText(&sb, []byte(s)) //nolint:errcheck // strings.Builder never returns an error
+	return sb.String()
+}
+
+//
+// AWS JSON 1.0 and 1.1 protocols.
+// https://smithy.io/2.0/aws/protocols/aws-json-1_0-protocol.html.
+//
+
+type jsonOperation func(ctx context.Context, input json.RawMessage) (any, error)
+
+// jsonHandler dispatches AWS JSON protocol requests on the value of the X-Amz-Target header.
+type jsonHandler struct {
+	targetPrefix string
+	operations   map[string]jsonOperation
+}
+
+func (h jsonHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	target := r.Header.Get("X-Amz-Target")
+	name, ok := strings.CutPrefix(target, h.targetPrefix+".")
+	if !ok {
+		writeJSONError(w, newAPIError(http.StatusBadRequest, "UnknownOperationException", "Unknown target %q", target))
+		return
+	}
+
+	operation, ok := h.operations[name]
+	if !ok {
+		writeJSONError(w, newAPIError(http.StatusBadRequest, "UnknownOperationException", "Operation %q is not implemented", name))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeJSONError(w, asAPIError(err))
+		return
+	}
+	if len(body) == 0 {
+		body = []byte("{}")
+	}
+
+	output, err := operation(r.Context(), body)
+	if err != nil {
+		writeJSONError(w, asAPIError(err))
+		return
+	}
+
+	if output == nil {
+		output = struct{}{}
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		body = []byte(fmt.Sprintf(`{"__type":"InternalFailure","message":%q}`, err))
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.Header().Set("X-Amzn-RequestId", newRequestID())
+	// Some clients, e.g. DynamoDB, validate the response checksum.
+	w.Header().Set("X-Amz-Crc32", strconv.FormatUint(uint64(crc32.ChecksumIEEE(body)), 10))
+	w.WriteHeader(statusCode)
+	w.Write(body) //nolint:errcheck // Nothing can be done if the client has gone away
+}
+
+func writeJSONError(w http.ResponseWriter, err *apiError) {
+	if err.queryCode != "" {
+		w.Header().Set("X-Amzn-Query-Error", err.queryCode+";Sender")
+	}
+
+	writeJSON(w, err.statusCode, map[string]string{
+		"__type":  err.code,
+		"message": err.message,
+	})
+}
+
+func unmarshalJSONInput(input json.RawMessage, v any) error {
+	if err := json.Unmarshal(input, v); err != nil {
+		return newAPIError(http.StatusBadRequest, "SerializationException", "%s", err)
+	}
+
+	return nil
+}
+
+//
+// AWS Query protocol.
+// https://smithy.io/2.0/aws/protocols/aws-query-protocol.html.
+//
+
+type queryOperation func(ctx context.Context, input url.Values) (any, error)
+
+// queryHandler dispatches AWS Query protocol requests on the value of the Action parameter.
+type queryHandler struct {
+	xmlns      string
+	operations map[string]queryOperation
+}
+
+func (h queryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeQueryError(w, newAPIError(http.StatusBadRequest, "MalformedQueryString", "%s", err))
+		return
+	}
+
+	action := r.Form.Get("Action")
+	operation, ok := h.operations[action]
+	if !ok {
+		writeQueryError(w, newAPIError(http.StatusBadRequest, "InvalidAction", "Operation %q is not implemented", action))
+		return
+	}
+
+	output, err := operation(r.Context(), r.Form)
+	if err != nil {
+		writeQueryError(w, asAPIError(err))
+		return
+	}
+
+	if output == nil {
+		output = struct{}{}
+	}
+
+	writeXML(w, http.StatusOK, &queryResponse{
+		action: action,
+		xmlns:  h.xmlns,
+		result: output,
+	})
+}
+
+// queryResponse is the XML envelope of an AWS Query protocol response.
+type queryResponse struct {
+	action string
+	xmlns  string
+	result any
+}
+
+func (r *queryResponse) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := xml.StartElement{
+		Name: xml.Name{Local: r.action + "Response"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: r.xmlns}},
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if err := e.EncodeElement(r.result, xml.StartElement{Name: xml.Name{Local: r.action + "Result"}}); err != nil {
+		return err
+	}
+
+	metadata := struct {
+		RequestID string `xml:"RequestId"`
+	}{
+		RequestID: newRequestID(),
+	}
+	if err := e.EncodeElement(metadata, xml.StartElement{Name: xml.Name{Local: "ResponseMetadata"}}); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+type queryErrorResponse struct {
+	XMLName   xml.Name `xml:"ErrorResponse"`
+	Type      string   `xml:"Error>Type"`
+	Code      string   `xml:"Error>Code"`
+	Message   string   `xml:"Error>Message"`
+	RequestID string   `xml:"RequestId"`
+}
+
+func writeQueryError(w http.ResponseWriter, err *apiError) {
+	writeXML(w, err.statusCode, &queryErrorResponse{
+		Type:      "Sender",
+		Code:      err.code,
+		Message:   err.message,
+		RequestID: newRequestID(),
+	})
+}
+
+func writeXML(w http.ResponseWriter, statusCode int, v any) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		body = []byte(fmt.Sprintf("<Error><Code>InternalError</Code><Message>%s</Message></Error>", xmlEscape(err.Error())))
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("X-Amzn-RequestId", newRequestID())
+	w.WriteHeader(statusCode)
+	io.WriteString(w, xml.Header) //nolint:errcheck // Nothing can be done if the client has gone away
+	w.Write(body)                 //nolint:errcheck // Nothing can be done if the client has gone away
+}
+
+// queryStringList returns the members of a serialized list, e.g. `TagKeys.member.1`.
+func queryStringList(input url.Values, prefix string) []string {
+	var list []string
+
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s.%d", prefix, i)
+		if !input.Has(k) {
+			break
+		}
+		list = append(list, input.Get(k))
+	}
+
+	return list
+}
+
+// queryStringMap returns the entries of a serialized list of structures with key and value members, e.g. `Tags.member.1.Key`.
+func queryStringMap(input url.Values, prefix, keyName, valueName string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s.%d.%s", prefix, i, keyName)
+		if !input.Has(k) {
+			break
+		}
+		m[input.Get(k)] = input.Get(fmt.Sprintf("%s.%d.%s", prefix, i, valueName))
+	}
+
+	return m
+}
+
+// requiredQueryParameter returns the value of a required input parameter.
+func requiredQueryParameter(input url.Values, name string) (string, error) {
+	v := input.Get(name)
+	if v == "" {
+		return "", newAPIError(http.StatusBadRequest, "MissingParameter", "The request must contain the parameter %s", name)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,744 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	s3XMLNamespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+)
+
+type s3Service struct {
+	mu      sync.Mutex
+	buckets map[string]*s3Bucket // Keyed by bucket name.
+}
+
+type s3Bucket struct {
+	creationDate time.Time
+	name         string
+	objects      map[string]*s3Object // Keyed by object key.
+	region       string
+	tags         map[string]string
+	versioning   string
+}
+
+type s3Object struct {
+	body         []byte
+	contentType  string
+	etag         string
+	lastModified time.Time
+	metadata     http.Header
+}
+
+// NewS3Service returns a fake Amazon Simple Storage Service (S3) API.
+// Only path-style requests for buckets, bucket tagging and versioning, and unversioned objects are supported.
+// Requests for other bucket or object subresources, e.g. `?policy`, return a `NotImplemented` error as returned by many third-party S3 API implementations.
+func NewS3Service() Service {
+	return &s3Service{
+		buckets: make(map[string]*s3Bucket),
+	}
+}
+
+func (s *s3Service) EndpointKey() string {
+	return names.S3
+}
+
+func (s *s3Service) SigningName() string {
+	return "s3"
+}
+
+func (s *s3Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	// The operation is identified by the method, path and subresource.
+	query := r.URL.Query()
+	query.Del("x-id")
+	var subresource string
+	for _, v := range []string{"delete", "list-type", "location", "tagging", "versioning", "versions"} {
+		if query.Has(v) {
+			subresource = v
+			break
+		}
+	}
+	if subresource == "" {
+		for k := range query {
+			switch k {
+			case "continuation-token", "delimiter", "encoding-type", "key-marker", "marker", "max-keys", "prefix", "start-after", "version-id-marker", "versionId":
+			default:
+				subresource = k
+			}
+		}
+	}
+
+	var err error
+	switch {
+	case bucket == "" && r.Method == http.MethodGet && subresource == "":
+		err = s.listBuckets(w, r)
+	case bucket == "":
+		err = s3NotImplementedError()
+	case key == "":
+		err = s.serveBucket(w, r, bucket, subresource)
+	default:
+		err = s.serveObject(w, r, bucket, key, subresource)
+	}
+
+	if err != nil {
+		writeS3Error(w, r, asAPIError(err))
+	}
+}
+
+func (s *s3Service) serveBucket(w http.ResponseWriter, r *http.Request, bucket, subresource string) error {
+	switch r.Method + " " + subresource {
+	case "DELETE ":
+		return s.deleteBucket(w, r, bucket)
+	case "DELETE tagging":
+		return s.deleteBucketTagging(w, r, bucket)
+	case "GET ":
+		return s.listObjects(w, r, bucket, false)
+	case "GET list-type":
+		return s.listObjects(w, r, bucket, true)
+	case "GET location":
+		return s.getBucketLocation(w, r, bucket)
+	case "GET tagging":
+		return s.getBucketTagging(w, r, bucket)
+	case "GET versioning":
+		return s.getBucketVersioning(w, r, bucket)
+	case "GET versions":
+		return s.listObjectVersions(w, r, bucket)
+	case "HEAD ":
+		return s.headBucket(w, r, bucket)
+	case "POST delete":
+		return s.deleteObjects(w, r, bucket)
+	case "PUT ":
+		return s.createBucket(w, r, bucket)
+	case "PUT tagging":
+		return s.putBucketTagging(w, r, bucket)
+	case "PUT versioning":
+		return s.putBucketVersioning(w, r, bucket)
+	default:
+		return s3NotImplementedError()
+	}
+}
+
+func (s *s3Service) serveObject(w http.ResponseWriter, r *http.Request, bucket, key, subresource string) error {
+	if subresource != "" || r.Header.Get("X-Amz-Copy-Source") != "" {
+		return s3NotImplementedError()
+	}
+
+	switch r.Method {
+	case http.MethodDelete:
+		return s.deleteObject(w, r, bucket, key)
+	case http.MethodGet:
+		return s.getObject(w, r, bucket, key, true)
+	case http.MethodHead:
+		return s.getObject(w, r, bucket, key, false)
+	case http.MethodPut:
+		return s.putObject(w, r, bucket, key)
+	default:
+		return s3NotImplementedError()
+	}
+}
+
+func s3NotImplementedError() *apiError {
+	return newAPIError(http.StatusNotImplemented, "NotImplemented", "A header or query you provided implies functionality that is not implemented.")
+}
+
+func s3NoSuchBucketError(bucket string) *apiError {
+	return newAPIError(http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist: %s", bucket)
+}
+
+func writeS3Error(w http.ResponseWriter, r *http.Request, err *apiError) {
+	// Responses to HEAD requests have no body.
+	if r.Method == http.MethodHead {
+		w.Header().Set("X-Amz-Request-Id", newRequestID())
+		w.WriteHeader(err.statusCode)
+		return
+	}
+
+	writeXML(w, err.statusCode, &struct {
+		XMLName   xml.Name `xml:"Error"`
+		Code      string   `xml:"Code"`
+		Message   string   `xml:"Message"`
+		RequestID string   `xml:"RequestId"`
+	}{
+		Code:      err.code,
+		Message:   err.message,
+		RequestID: newRequestID(),
+	})
+}
+
+// findBucket returns the specified bucket.
+// The caller must hold s.mu.
+func (s *s3Service) findBucket(bucket string) (*s3Bucket, error) {
+	b, ok := s.buckets[bucket]
+	if !ok {
+		return nil, s3NoSuchBucketError(bucket)
+	}
+
+	return b, nil
+}
+
+// readS3Body reads a request body, decoding any `aws-chunked` content encoding.
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") && !strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked") {
+		return io.ReadAll(r.Body)
+	}
+
+	// https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-streaming.html.
+	var body bytes.Buffer
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, newAPIError(http.StatusBadRequest, "IncompleteBody", "%s", err)
+		}
+
+		size, _, _ := strings.Cut(strings.TrimSpace(header), ";")
+		n, err := strconv.ParseInt(size, 16, 64)
+		if err != nil {
+			return nil, newAPIError(http.StatusBadRequest, "InvalidChunkSizeError", "%s", err)
+		}
+
+		if n == 0 {
+			// Any trailing headers, e.g. checksums, are ignored.
+			return body.Bytes(), nil
+		}
+
+		if _, err := io.CopyN(&body, reader, n); err != nil {
+			return nil, newAPIError(http.StatusBadRequest, "IncompleteBody", "%s", err)
+		}
+
+		if _, err := reader.Discard(2); err != nil { // CRLF.
+			return nil, newAPIError(http.StatusBadRequest, "IncompleteBody", "%s", err)
+		}
+	}
+}
+
+func decodeS3XMLBody(r *http.Request, v any) error {
+	body, err := readS3Body(r)
+	if err != nil {
+		return err
+	}
+
+	if err := xml.Unmarshal(body, v); err != nil {
+		return newAPIError(http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+	}
+
+	return nil
+}
+
+type s3Tag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type s3Tagging struct {
+	XMLName xml.Name `xml:"Tagging"`
+	Xmlns   string   `xml:"xmlns,attr,omitempty"`
+	TagSet  []s3Tag  `xml:"TagSet>Tag"`
+}
+
+func (s *s3Service) createBucket(w http.ResponseWriter, r *http.Request, bucket string) error {
+	var configuration struct {
+		LocationConstraint string `xml:"LocationConstraint"`
+	}
+	body, err := readS3Body(r)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := xml.Unmarshal(body, &configuration); err != nil {
+			return newAPIError(http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+		}
+	}
+
+	region := Region(r.Context())
+	switch configuration.LocationConstraint {
+	case "":
+		if region != defaultRegion {
+			return newAPIError(http.StatusBadRequest, "IllegalLocationConstraintException", "The unspecified location constraint is incompatible for the region specific endpoint this request was sent to.")
+		}
+	case region:
+	default:
+		return newAPIError(http.StatusBadRequest, "IllegalLocationConstraintException", "The %s location constraint is incompatible for the region specific endpoint this request was sent to.", configuration.LocationConstraint)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[bucket]; ok {
+		return newAPIError(http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	s.buckets[bucket] = &s3Bucket{
+		creationDate: time.Now().UTC().Truncate(time.Second),
+		name:         bucket,
+		objects:      make(map[string]*s3Object),
+		region:       region,
+		tags:         make(map[string]string),
+	}
+
+	w.Header().Set("Location", "/"+bucket)
+	w.WriteHeader(http.StatusOK)
+
+	return nil
+}
+
+func (s *s3Service) deleteBucket(w http.ResponseWriter, _ *http.Request, bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	if len(b.objects) > 0 {
+		return newAPIError(http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+	}
+
+	delete(s.buckets, bucket)
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+func (s *s3Service) deleteBucketTagging(w http.ResponseWriter, _ *http.Request, bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	clear(b.tags)
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+func (s *s3Service) deleteObject(w http.ResponseWriter, _ *http.Request, bucket, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	// DeleteObject is idempotent.
+	delete(b.objects, key)
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+func (s *s3Service) deleteObjects(w http.ResponseWriter, r *http.Request, bucket string) error {
+	var in struct {
+		Objects []struct {
+			Key string `xml:"Key"`
+		} `xml:"Object"`
+		Quiet bool `xml:"Quiet"`
+	}
+	if err := decodeS3XMLBody(r, &in); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	type deleted struct {
+		Key string `xml:"Key"`
+	}
+	var output []deleted
+	for _, object := range in.Objects {
+		delete(b.objects, object.Key)
+		if !in.Quiet {
+			output = append(output, deleted{Key: object.Key})
+		}
+	}
+
+	writeXML(w, http.StatusOK, &struct {
+		XMLName xml.Name  `xml:"DeleteResult"`
+		Xmlns   string    `xml:"xmlns,attr"`
+		Deleted []deleted `xml:"Deleted"`
+	}{
+		Xmlns:   s3XMLNamespace,
+		Deleted: output,
+	})
+
+	return nil
+}
+
+func (s *s3Service) getBucketLocation(w http.ResponseWriter, _ *http.Request, bucket string) error {
+	s.mu.Lock()
+	b, err := s.findBucket(bucket)
+	s.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	location := b.region
+	if location == defaultRegion {
+		location = ""
+	}
+
+	writeXML(w, http.StatusOK, &struct {
+		XMLName            xml.Name `xml:"LocationConstraint"`
+		Xmlns              string   `xml:"xmlns,attr"`
+		LocationConstraint string   `xml:",chardata"`
+	}{
+		Xmlns:              s3XMLNamespace,
+		LocationConstraint: location,
+	})
+
+	return nil
+}
+
+func (s *s3Service) getBucketTagging(w http.ResponseWriter, _ *http.Request, bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	if len(b.tags) == 0 {
+		return newAPIError(http.StatusNotFound, "NoSuchTagSet", "The TagSet does not exist")
+	}
+
+	output := &s3Tagging{
+		Xmlns: s3XMLNamespace,
+	}
+	for _, k := range slices.Sorted(maps.Keys(b.tags)) {
+		output.TagSet = append(output.TagSet, s3Tag{Key: k, Value: b.tags[k]})
+	}
+
+	writeXML(w, http.StatusOK, output)
+
+	return nil
+}
+
+func (s *s3Service) getBucketVersioning(w http.ResponseWriter, _ *http.Request, bucket string) error {
+	s.mu.Lock()
+	b, err := s.findBucket(bucket)
+	s.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	writeXML(w, http.StatusOK, &struct {
+		XMLName xml.Name `xml:"VersioningConfiguration"`
+		Xmlns   string   `xml:"xmlns,attr"`
+		Status  string   `xml:"Status,omitempty"`
+	}{
+		Xmlns:  s3XMLNamespace,
+		Status: b.versioning,
+	})
+
+	return nil
+}
+
+func (s *s3Service) getObject(w http.ResponseWriter, _ *http.Request, bucket, key string, withBody bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	object, ok := b.objects[key]
+	if !ok {
+		return newAPIError(http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+	}
+
+	for k, v := range object.metadata {
+		w.Header()[k] = v
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(object.body)))
+	w.Header().Set("Content-Type", object.contentType)
+	w.Header().Set("ETag", object.etag)
+	w.Header().Set("Last-Modified", object.lastModified.Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+
+	if withBody {
+		w.Write(object.body) //nolint:errcheck // Nothing can be done if the client has gone away
+	}
+
+	return nil
+}
+
+func (s *s3Service) headBucket(w http.ResponseWriter, _ *http.Request, bucket string) error {
+	s.mu.Lock()
+	b, err := s.findBucket(bucket)
+	s.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("X-Amz-Bucket-Region", b.region)
+	w.WriteHeader(http.StatusOK)
+
+	return nil
+}
+
+func (s *s3Service) listBuckets(w http.ResponseWriter, _ *http.Request) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type bucket struct {
+		CreationDate time.Time `xml:"CreationDate"`
+		Name         string    `xml:"Name"`
+	}
+	var buckets []bucket
+	for _, name := range slices.Sorted(maps.Keys(s.buckets)) {
+		buckets = append(buckets, bucket{
+			CreationDate: s.buckets[name].creationDate,
+			Name:         name,
+		})
+	}
+
+	writeXML(w, http.StatusOK, &struct {
+		XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+		Xmlns   string   `xml:"xmlns,attr"`
+		Buckets []bucket `xml:"Buckets>Bucket"`
+		OwnerID string   `xml:"Owner>ID"`
+	}{
+		Xmlns:   s3XMLNamespace,
+		Buckets: buckets,
+		OwnerID: AccountID,
+	})
+
+	return nil
+}
+
+type s3ObjectSummary struct {
+	ETag         string    `xml:"ETag"`
+	Key          string    `xml:"Key"`
+	LastModified time.Time `xml:"LastModified"`
+	Size         int       `xml:"Size"`
+	StorageClass string    `xml:"StorageClass"`
+}
+
+// objectSummaries returns summaries of the bucket's objects whose keys begin with prefix.
+// The caller must hold s.mu.
+func (b *s3Bucket) objectSummaries(prefix string) []s3ObjectSummary {
+	var summaries []s3ObjectSummary
+
+	for _, key := range slices.Sorted(maps.Keys(b.objects)) {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		object := b.objects[key]
+		summaries = append(summaries, s3ObjectSummary{
+			ETag:         object.etag,
+			Key:          key,
+			LastModified: object.lastModified,
+			Size:         len(object.body),
+			StorageClass: "STANDARD",
+		})
+	}
+
+	return summaries
+}
+
+func (s *s3Service) listObjects(w http.ResponseWriter, r *http.Request, bucket string, v2 bool) error {
+	prefix := r.URL.Query().Get("prefix")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	contents := b.objectSummaries(prefix)
+	output := &struct {
+		XMLName     xml.Name          `xml:"ListBucketResult"`
+		Xmlns       string            `xml:"xmlns,attr"`
+		Contents    []s3ObjectSummary `xml:"Contents"`
+		IsTruncated bool              `xml:"IsTruncated"`
+		KeyCount    *int              `xml:"KeyCount,omitempty"`
+		MaxKeys     int               `xml:"MaxKeys"`
+		Name        string            `xml:"Name"`
+		Prefix      string            `xml:"Prefix"`
+	}{
+		Xmlns:    s3XMLNamespace,
+		Contents: contents,
+		MaxKeys:  1000,
+		Name:     bucket,
+		Prefix:   prefix,
+	}
+	if v2 {
+		keyCount := len(contents)
+		output.KeyCount = &keyCount
+	}
+
+	writeXML(w, http.StatusOK, output)
+
+	return nil
+}
+
+func (s *s3Service) listObjectVersions(w http.ResponseWriter, r *http.Request, bucket string) error {
+	prefix := r.URL.Query().Get("prefix")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	type version struct {
+		s3ObjectSummary
+		IsLatest  bool   `xml:"IsLatest"`
+		VersionID string `xml:"VersionId"`
+	}
+	var versions []version
+	for _, summary := range b.objectSummaries(prefix) {
+		versions = append(versions, version{
+			s3ObjectSummary: summary,
+			IsLatest:        true,
+			VersionID:       "null",
+		})
+	}
+
+	writeXML(w, http.StatusOK, &struct {
+		XMLName     xml.Name  `xml:"ListVersionsResult"`
+		Xmlns       string    `xml:"xmlns,attr"`
+		IsTruncated bool      `xml:"IsTruncated"`
+		MaxKeys     int       `xml:"MaxKeys"`
+		Name        string    `xml:"Name"`
+		Prefix      string    `xml:"Prefix"`
+		Versions    []version `xml:"Version"`
+	}{
+		Xmlns:    s3XMLNamespace,
+		MaxKeys:  1000,
+		Name:     bucket,
+		Prefix:   prefix,
+		Versions: versions,
+	})
+
+	return nil
+}
+
+func (s *s3Service) putBucketTagging(w http.ResponseWriter, r *http.Request, bucket string) error {
+	var in s3Tagging
+	if err := decodeS3XMLBody(r, &in); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	clear(b.tags)
+	for _, tag := range in.TagSet {
+		b.tags[tag.Key] = tag.Value
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+func (s *s3Service) putBucketVersioning(w http.ResponseWriter, r *http.Request, bucket string) error {
+	var in struct {
+		Status string `xml:"Status"`
+	}
+	if err := decodeS3XMLBody(r, &in); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	b.versioning = in.Status
+
+	w.WriteHeader(http.StatusOK)
+
+	return nil
+}
+
+func (s *s3Service) putObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	body, err := readS3Body(r)
+	if err != nil {
+		return err
+	}
+
+	sum := md5.Sum(body) //nolint:gosec // ETags are MD5 digests
+	object := &s3Object{
+		body:         body,
+		contentType:  r.Header.Get("Content-Type"),
+		etag:         fmt.Sprintf("%q", hex.EncodeToString(sum[:])),
+		lastModified: time.Now().UTC().Truncate(time.Second),
+		metadata:     make(http.Header),
+	}
+	if object.contentType == "" {
+		object.contentType = "binary/octet-stream"
+	}
+	for k, v := range r.Header {
+		if strings.HasPrefix(k, "X-Amz-Meta-") {
+			object.metadata[k] = v
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.findBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	b.objects[key] = object
+
+	w.Header().Set("ETag", object.etag)
+	w.WriteHeader(http.StatusOK)
+
+	return nil
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type snsService struct {
+	queryHandler
+
+	mu     sync.Mutex
+	topics map[string]*snsTopic // Keyed by ARN.
+}
+
+type snsTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// NewSNSService returns a fake Amazon Simple Notification Service (SNS) API.
+func NewSNSService() Service {
+	s := &snsService{
+		topics: make(map[string]*snsTopic),
+	}
+	s.queryHandler = queryHandler{
+		xmlns: "http://sns.amazonaws.com/doc/2010-03-31/",
+		operations: map[string]queryOperation{
+			"CreateTopic":         s.createTopic,
+			"DeleteTopic":         s.deleteTopic,
+			"GetTopicAttributes":  s.getTopicAttributes,
+			"ListTagsForResource": s.listTagsForResource,
+			"ListTopics":          s.listTopics,
+			"SetTopicAttributes":  s.setTopicAttributes,
+			"TagResource":         s.tagResource,
+			"UntagResource":       s.untagResource,
+		},
+	}
+
+	return s
+}
+
+func (s *snsService) EndpointKey() string {
+	return names.SNS
+}
+
+func (s *snsService) SigningName() string {
+	return "sns"
+}
+
+// findTopic returns the topic with the specified ARN.
+// The caller must hold s.mu.
+func (s *snsService) findTopic(arn string) (*snsTopic, error) {
+	topic, ok := s.topics[arn]
+	if !ok {
+		return nil, newAPIError(http.StatusNotFound, "NotFound", "Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+func snsDefaultTopicPolicy(arn string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":%[1]q,"Condition":{"StringEquals":{"AWS:SourceOwner":%[2]q}}}]}`, arn, AccountID)
+}
+
+type snsAttributeEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type snsTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+func (s *snsService) createTopic(ctx context.Context, input url.Values) (any, error) {
+	name, err := requiredQueryParameter(input, "Name")
+	if err != nil {
+		return nil, err
+	}
+
+	arn := regionalARN(ctx, "sns", name)
+	attributes := map[string]string{
+		"DisplayName":             "",
+		"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`,
+		"Owner":                   AccountID,
+		"Policy":                  snsDefaultTopicPolicy(arn),
+		"SubscriptionsConfirmed":  "0",
+		"SubscriptionsDeleted":    "0",
+		"SubscriptionsPending":    "0",
+		"TopicArn":                arn,
+	}
+	if strings.HasSuffix(name, ".fifo") {
+		attributes["ContentBasedDeduplication"] = "false"
+		attributes["FifoTopic"] = "true"
+	}
+	maps.Copy(attributes, queryStringMap(input, "Attributes.entry", "key", "value"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// CreateTopic is idempotent.
+	if _, ok := s.topics[arn]; !ok {
+		s.topics[arn] = &snsTopic{
+			attributes: attributes,
+			tags:       queryStringMap(input, "Tags.member", "Key", "Value"),
+		}
+	}
+
+	return struct {
+		TopicARN string `xml:"TopicArn"`
+	}{
+		TopicARN: arn,
+	}, nil
+}
+
+func (s *snsService) deleteTopic(_ context.Context, input url.Values) (any, error) {
+	arn, err := requiredQueryParameter(input, "TopicArn")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// DeleteTopic is idempotent.
+	delete(s.topics, arn)
+
+	return nil, nil
+}
+
+func (s *snsService) getTopicAttributes(_ context.Context, input url.Values) (any, error) {
+	arn, err := requiredQueryParameter(input, "TopicArn")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.findTopic(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []snsAttributeEntry
+	for _, k := range slices.Sorted(maps.Keys(topic.attributes)) {
+		entries = append(entries, snsAttributeEntry{Key: k, Value: topic.attributes[k]})
+	}
+
+	return struct {
+		Attributes []snsAttributeEntry `xml:"Attributes>entry"`
+	}{
+		Attributes: entries,
+	}, nil
+}
+
+func (s *snsService) listTagsForResource(_ context.Context, input url.Values) (any, error) {
+	arn, err := requiredQueryParameter(input, "ResourceArn")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.findTopic(arn)
+	if err != nil {
+		return nil, newAPIError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	tags := []snsTag{}
+	for _, k := range slices.Sorted(maps.Keys(topic.tags)) {
+		tags = append(tags, snsTag{Key: k, Value: topic.tags[k]})
+	}
+
+	return struct {
+		Tags []snsTag `xml:"Tags>member"`
+	}{
+		Tags: tags,
+	}, nil
+}
+
+func (s *snsService) listTopics(ctx context.Context, _ url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type topic struct {
+		TopicARN string `xml:"TopicArn"`
+	}
+	var topics []topic
+	prefix := regionalARN(ctx, "sns", "")
+	for _, arn := range slices.Sorted(maps.Keys(s.topics)) {
+		if strings.HasPrefix(arn, prefix) {
+			topics = append(topics, topic{TopicARN: arn})
+		}
+	}
+
+	return struct {
+		Topics []topic `xml:"Topics>member"`
+	}{
+		Topics: topics,
+	}, nil
+}
+
+func (s *snsService) setTopicAttributes(_ context.Context, input url.Values) (any, error) {
+	arn, err := requiredQueryParameter(input, "TopicArn")
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := requiredQueryParameter(input, "AttributeName")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.findTopic(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := input.Get("AttributeValue"); {
+	case value != "":
+		topic.attributes[name] = value
+	case name == "Policy":
+		// Removing the topic policy restores the default policy.
+		topic.attributes[name] = snsDefaultTopicPolicy(arn)
+	default:
+		delete(topic.attributes, name)
+	}
+
+	return nil, nil
+}
+
+func (s *snsService) tagResource(_ context.Context, input url.Values) (any, error) {
+	arn, err := requiredQueryParameter(input, "ResourceArn")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.findTopic(arn)
+	if err != nil {
+		return nil, newAPIError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	maps.Copy(topic.tags, queryStringMap(input, "Tags.member", "Key", "Value"))
+
+	return nil, nil
+}
+
+func (s *snsService) untagResource(_ context.Context, input url.Values) (any, error) {
+	arn, err := requiredQueryParameter(input, "ResourceArn")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.findTopic(arn)
+	if err != nil {
+		return nil, newAPIError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	for _, k := range queryStringList(input, "TagKeys.member") {
+		delete(topic.tags, k)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type sqsService struct {
+	jsonHandler
+
+	mu     sync.Mutex
+	queues map[string]*sqsQueue // Keyed by Region and queue name.
+}
+
+type sqsQueue struct {
+	name       string
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// NewSQSService returns a fake Amazon Simple Queue Service (SQS) API.
+func NewSQSService() Service {
+	s := &sqsService{
+		queues: make(map[string]*sqsQueue),
+	}
+	s.jsonHandler = jsonHandler{
+		targetPrefix: "AmazonSQS",
+		operations: map[string]jsonOperation{
+			"CreateQueue":        s.createQueue,
+			"DeleteQueue":        s.deleteQueue,
+			"GetQueueAttributes": s.getQueueAttributes,
+			"GetQueueUrl":        s.getQueueURL,
+			"ListQueues":         s.listQueues,
+			"ListQueueTags":      s.listQueueTags,
+			"SetQueueAttributes": s.setQueueAttributes,
+			"TagQueue":           s.tagQueue,
+			"UntagQueue":         s.untagQueue,
+		},
+	}
+
+	return s
+}
+
+func (s *sqsService) EndpointKey() string {
+	return names.SQS
+}
+
+func (s *sqsService) SigningName() string {
+	return "sqs"
+}
+
+func sqsQueueDoesNotExistError() *apiError {
+	err := newAPIError(http.StatusBadRequest, "QueueDoesNotExist", "The specified queue does not exist.")
+	err.queryCode = "AWS.SimpleQueueService.NonExistentQueue"
+	return err
+}
+
+func sqsQueueURL(ctx context.Context, name string) string {
+	return endpoint(ctx) + "/" + AccountID + "/" + name
+}
+
+// findQueue returns the queue with the specified URL.
+// The caller must hold s.mu.
+func (s *sqsService) findQueue(ctx context.Context, queueURL string) (*sqsQueue, error) {
+	u, err := url.Parse(queueURL)
+	if err != nil {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidAddress", "The address %s is not valid for this endpoint.", queueURL)
+	}
+
+	queue, ok := s.queues[regionalKey(ctx, u.Path[strings.LastIndex(u.Path, "/")+1:])]
+	if !ok {
+		return nil, sqsQueueDoesNotExistError()
+	}
+
+	return queue, nil
+}
+
+func (s *sqsService) createQueue(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		QueueName  string
+		Attributes map[string]string
+		Tags       map[string]string `json:"tags"`
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	if in.QueueName == "" {
+		return nil, newAPIError(http.StatusBadRequest, "MissingParameter", "The request must contain the parameter QueueName.")
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	attributes := map[string]string{
+		"ApproximateNumberOfMessages":           "0",
+		"ApproximateNumberOfMessagesDelayed":    "0",
+		"ApproximateNumberOfMessagesNotVisible": "0",
+		"CreatedTimestamp":                      now,
+		"DelaySeconds":                          "0",
+		"LastModifiedTimestamp":                 now,
+		"MaximumMessageSize":                    "262144",
+		"MessageRetentionPeriod":                "345600",
+		"QueueArn":                              regionalARN(ctx, "sqs", in.QueueName),
+		"ReceiveMessageWaitTimeSeconds":         "0",
+		"SqsManagedSseEnabled":                  "true",
+		"VisibilityTimeout":                     "30",
+	}
+	if strings.HasSuffix(in.QueueName, ".fifo") {
+		attributes["ContentBasedDeduplication"] = "false"
+		attributes["DeduplicationScope"] = "queue"
+		attributes["FifoQueue"] = "true"
+		attributes["FifoThroughputLimit"] = "perQueue"
+	}
+	if _, ok := in.Attributes["KmsMasterKeyId"]; ok {
+		attributes["KmsDataKeyReusePeriodSeconds"] = "300"
+		attributes["SqsManagedSseEnabled"] = "false"
+	}
+	maps.Copy(attributes, in.Attributes)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := regionalKey(ctx, in.QueueName)
+	if queue, ok := s.queues[key]; ok {
+		for k, v := range in.Attributes {
+			if queue.attributes[k] != v {
+				err := newAPIError(http.StatusBadRequest, "QueueNameExists", "A queue already exists with the same name and a different value for attribute %s", k)
+				err.queryCode = "QueueAlreadyExists"
+				return nil, err
+			}
+		}
+	} else {
+		s.queues[key] = &sqsQueue{
+			name:       in.QueueName,
+			attributes: attributes,
+			tags:       in.Tags,
+		}
+	}
+
+	return map[string]string{
+		"QueueUrl": sqsQueueURL(ctx, in.QueueName),
+	}, nil
+}
+
+func (s *sqsService) deleteQueue(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		QueueURL string `json:"QueueUrl"`
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, err := s.findQueue(ctx, in.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.queues, regionalKey(ctx, queue.name))
+
+	return nil, nil
+}
+
+func (s *sqsService) getQueueAttributes(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		AttributeNames []string
+		QueueURL       string `json:"QueueUrl"`
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, err := s.findQueue(ctx, in.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string)
+	if slices.Contains(in.AttributeNames, "All") {
+		maps.Copy(attributes, queue.attributes)
+	} else {
+		for _, name := range in.AttributeNames {
+			if v, ok := queue.attributes[name]; ok {
+				attributes[name] = v
+			}
+		}
+	}
+
+	return map[string]any{
+		"Attributes": attributes,
+	}, nil
+}
+
+func (s *sqsService) getQueueURL(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		QueueName string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.queues[regionalKey(ctx, in.QueueName)]; !ok {
+		return nil, sqsQueueDoesNotExistError()
+	}
+
+	return map[string]string{
+		"QueueUrl": sqsQueueURL(ctx, in.QueueName),
+	}, nil
+}
+
+func (s *sqsService) listQueues(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		QueueNamePrefix string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queueURLs := []string{}
+	for key, queue := range s.queues {
+		if key == regionalKey(ctx, queue.name) && strings.HasPrefix(queue.name, in.QueueNamePrefix) {
+			queueURLs = append(queueURLs, sqsQueueURL(ctx, queue.name))
+		}
+	}
+	slices.Sort(queueURLs)
+
+	return map[string]any{
+		"QueueUrls": queueURLs,
+	}, nil
+}
+
+func (s *sqsService) listQueueTags(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		QueueURL string `json:"QueueUrl"`
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, err := s.findQueue(ctx, in.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"Tags": maps.Clone(queue.tags),
+	}, nil
+}
+
+func (s *sqsService) setQueueAttributes(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		Attributes map[string]string
+		QueueURL   string `json:"QueueUrl"`
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, err := s.findQueue(ctx, in.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range in.Attributes {
+		// Setting an attribute to an empty value removes it, e.g. Policy or RedrivePolicy.
+		if v == "" {
+			delete(queue.attributes, k)
+		} else {
+			queue.attributes[k] = v
+		}
+	}
+	queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return nil, nil
+}
+
+func (s *sqsService) tagQueue(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		QueueURL string `json:"QueueUrl"`
+		Tags     map[string]string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, err := s.findQueue(ctx, in.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if queue.tags == nil {
+		queue.tags = make(map[string]string)
+	}
+	maps.Copy(queue.tags, in.Tags)
+
+	return nil, nil
+}
+
+func (s *sqsService) untagQueue(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		QueueURL string `json:"QueueUrl"`
+		TagKeys  []string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, err := s.findQueue(ctx, in.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range in.TagKeys {
+		delete(queue.tags, k)
+	}
+
+	return nil, nil
+}
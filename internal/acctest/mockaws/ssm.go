@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type ssmService struct {
+	jsonHandler
+
+	mu         sync.Mutex
+	parameters map[string]*ssmParameter // Keyed by Region and parameter name.
+}
+
+type ssmParameter struct {
+	allowedPattern   string
+	arn              string
+	dataType         string
+	description      string
+	keyID            string
+	lastModifiedDate time.Time
+	name             string
+	tags             map[string]string
+	tier             string
+	typ              string
+	value            string
+	version          int64
+}
+
+// NewSSMService returns a fake AWS Systems Manager (SSM) API.
+// Only Parameter Store parameters are supported.
+func NewSSMService() Service {
+	s := &ssmService{
+		parameters: make(map[string]*ssmParameter),
+	}
+	s.jsonHandler = jsonHandler{
+		targetPrefix: "AmazonSSM",
+		operations: map[string]jsonOperation{
+			"AddTagsToResource":      s.addTagsToResource,
+			"DeleteParameter":        s.deleteParameter,
+			"DescribeParameters":     s.describeParameters,
+			"GetParameter":           s.getParameter,
+			"GetParameters":          s.getParameters,
+			"ListTagsForResource":    s.listTagsForResource,
+			"PutParameter":           s.putParameter,
+			"RemoveTagsFromResource": s.removeTagsFromResource,
+		},
+	}
+
+	return s
+}
+
+func (s *ssmService) EndpointKey() string {
+	return names.SSM
+}
+
+func (s *ssmService) SigningName() string {
+	return "ssm"
+}
+
+func ssmParameterNotFoundError(name string) *apiError {
+	return newAPIError(http.StatusBadRequest, "ParameterNotFound", "Parameter %s not found.", name)
+}
+
+type ssmTag struct {
+	Key   string
+	Value string
+}
+
+// findTaggableParameter returns the parameter identified by a tagging API request.
+// The caller must hold s.mu.
+func (s *ssmService) findTaggableParameter(ctx context.Context, resourceType, resourceID string) (*ssmParameter, error) {
+	if resourceType != "Parameter" {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidResourceType", "Resource type %s is not supported", resourceType)
+	}
+
+	parameter, ok := s.parameters[regionalKey(ctx, resourceID)]
+	if !ok {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidResourceId", "The resource ID %s is not valid", resourceID)
+	}
+
+	return parameter, nil
+}
+
+type ssmParameterOutput struct {
+	ARN              string
+	DataType         string
+	LastModifiedDate float64
+	Name             string
+	Type             string
+	Value            string
+	Version          int64
+}
+
+func (p *ssmParameter) output() *ssmParameterOutput {
+	return &ssmParameterOutput{
+		ARN:              p.arn,
+		DataType:         p.dataType,
+		LastModifiedDate: float64(p.lastModifiedDate.Unix()),
+		Name:             p.name,
+		Type:             p.typ,
+		// SecureString values are stored and returned in plain text.
+		Value:   p.value,
+		Version: p.version,
+	}
+}
+
+type ssmParameterMetadataOutput struct {
+	ARN              string
+	AllowedPattern   string `json:",omitempty"`
+	DataType         string
+	Description      string `json:",omitempty"`
+	KeyID            string `json:"KeyId,omitempty"`
+	LastModifiedDate float64
+	Name             string
+	Policies         []struct{}
+	Tier             string
+	Type             string
+	Version          int64
+}
+
+func (p *ssmParameter) metadataOutput() *ssmParameterMetadataOutput {
+	return &ssmParameterMetadataOutput{
+		ARN:              p.arn,
+		AllowedPattern:   p.allowedPattern,
+		DataType:         p.dataType,
+		Description:      p.description,
+		KeyID:            p.keyID,
+		LastModifiedDate: float64(p.lastModifiedDate.Unix()),
+		Name:             p.name,
+		Policies:         []struct{}{},
+		Tier:             p.tier,
+		Type:             p.typ,
+		Version:          p.version,
+	}
+}
+
+func (s *ssmService) addTagsToResource(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		ResourceID   string `json:"ResourceId"`
+		ResourceType string
+		Tags         []ssmTag
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, err := s.findTaggableParameter(ctx, in.ResourceType, in.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range in.Tags {
+		parameter.tags[tag.Key] = tag.Value
+	}
+
+	return nil, nil
+}
+
+func (s *ssmService) deleteParameter(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		Name string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := regionalKey(ctx, in.Name)
+	if _, ok := s.parameters[key]; !ok {
+		return nil, ssmParameterNotFoundError(in.Name)
+	}
+
+	delete(s.parameters, key)
+
+	return nil, nil
+}
+
+func (s *ssmService) describeParameters(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		ParameterFilters []struct {
+			Key    string
+			Option string
+			Values []string
+		}
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameters := []*ssmParameterMetadataOutput{}
+	for _, key := range slices.Sorted(maps.Keys(s.parameters)) {
+		parameter := s.parameters[key]
+		if key != regionalKey(ctx, parameter.name) {
+			continue
+		}
+
+		match := true
+		for _, filter := range in.ParameterFilters {
+			switch filter.Key {
+			case "Name":
+				switch filter.Option {
+				case "", "Equals":
+					match = match && slices.Contains(filter.Values, parameter.name)
+				case "BeginsWith":
+					match = match && slices.ContainsFunc(filter.Values, func(v string) bool {
+						return strings.HasPrefix(parameter.name, v)
+					})
+				default:
+					return nil, newAPIError(http.StatusBadRequest, "InvalidFilterOption", "The filter option %s is not supported", filter.Option)
+				}
+			case "Type":
+				match = match && slices.Contains(filter.Values, parameter.typ)
+			default:
+				return nil, newAPIError(http.StatusBadRequest, "InvalidFilterKey", "The filter key %s is not supported", filter.Key)
+			}
+		}
+
+		if match {
+			parameters = append(parameters, parameter.metadataOutput())
+		}
+	}
+
+	return map[string]any{
+		"Parameters": parameters,
+	}, nil
+}
+
+func (s *ssmService) getParameter(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		Name string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, ok := s.parameters[regionalKey(ctx, in.Name)]
+	if !ok {
+		return nil, ssmParameterNotFoundError(in.Name)
+	}
+
+	return map[string]any{
+		"Parameter": parameter.output(),
+	}, nil
+}
+
+func (s *ssmService) getParameters(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		Names []string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameters, invalidParameters := []*ssmParameterOutput{}, []string{}
+	for _, name := range in.Names {
+		if parameter, ok := s.parameters[regionalKey(ctx, name)]; ok {
+			parameters = append(parameters, parameter.output())
+		} else {
+			invalidParameters = append(invalidParameters, name)
+		}
+	}
+
+	return map[string]any{
+		"InvalidParameters": invalidParameters,
+		"Parameters":        parameters,
+	}, nil
+}
+
+func (s *ssmService) listTagsForResource(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		ResourceID   string `json:"ResourceId"`
+		ResourceType string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, err := s.findTaggableParameter(ctx, in.ResourceType, in.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []ssmTag{}
+	for _, k := range slices.Sorted(maps.Keys(parameter.tags)) {
+		tags = append(tags, ssmTag{Key: k, Value: parameter.tags[k]})
+	}
+
+	return map[string]any{
+		"TagList": tags,
+	}, nil
+}
+
+func (s *ssmService) putParameter(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		AllowedPattern string
+		DataType       string
+		Description    string
+		KeyID          string `json:"KeyId"`
+		Name           string
+		Overwrite      bool
+		Tags           []ssmTag
+		Tier           string
+		Type           string
+		Value          string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	if in.Name == "" || in.Value == "" {
+		return nil, newValidationError("Name and Value must be specified")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := regionalKey(ctx, in.Name)
+	parameter, ok := s.parameters[key]
+	if ok && !in.Overwrite {
+		return nil, newAPIError(http.StatusBadRequest, "ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+	}
+
+	if !ok {
+		resource := "parameter/" + in.Name
+		if strings.HasPrefix(in.Name, "/") {
+			resource = "parameter" + in.Name
+		}
+
+		parameter = &ssmParameter{
+			arn:      regionalARN(ctx, "ssm", resource),
+			dataType: "text",
+			name:     in.Name,
+			tags:     make(map[string]string),
+			tier:     "Standard",
+			typ:      "String",
+		}
+		s.parameters[key] = parameter
+	}
+
+	if in.AllowedPattern != "" || ok {
+		parameter.allowedPattern = in.AllowedPattern
+	}
+	if in.DataType != "" {
+		parameter.dataType = in.DataType
+	}
+	if in.Description != "" || ok {
+		parameter.description = in.Description
+	}
+	if in.Tier != "" && in.Tier != "Intelligent-Tiering" {
+		parameter.tier = in.Tier
+	}
+	if in.Type != "" {
+		parameter.typ = in.Type
+	}
+	switch {
+	case parameter.typ != "SecureString":
+		parameter.keyID = ""
+	case in.KeyID != "":
+		parameter.keyID = in.KeyID
+	case parameter.keyID == "":
+		parameter.keyID = "alias/aws/ssm"
+	}
+	for _, tag := range in.Tags {
+		parameter.tags[tag.Key] = tag.Value
+	}
+	parameter.lastModifiedDate = time.Now()
+	parameter.value = in.Value
+	parameter.version++
+
+	return map[string]any{
+		"Tier":    parameter.tier,
+		"Version": parameter.version,
+	}, nil
+}
+
+func (s *ssmService) removeTagsFromResource(ctx context.Context, input json.RawMessage) (any, error) {
+	var in struct {
+		ResourceID   string `json:"ResourceId"`
+		ResourceType string
+		TagKeys      []string
+	}
+	if err := unmarshalJSONInput(input, &in); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, err := s.findTaggableParameter(ctx, in.ResourceType, in.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range in.TagKeys {
+		delete(parameter.tags, k)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type stsService struct {
+	queryHandler
+}
+
+// NewSTSService returns a fake AWS Security Token Service (STS) API.
+// Only GetCallerIdentity is supported, so that the provider can determine the account ID.
+func NewSTSService() Service {
+	s := &stsService{}
+	s.queryHandler = queryHandler{
+		xmlns: "https://sts.amazonaws.com/doc/2011-06-15/",
+		operations: map[string]queryOperation{
+			"GetCallerIdentity": s.getCallerIdentity,
+		},
+	}
+
+	return s
+}
+
+func (s *stsService) EndpointKey() string {
+	return names.STS
+}
+
+func (s *stsService) SigningName() string {
+	return "sts"
+}
+
+type stsGetCallerIdentityResult struct {
+	Account string `xml:"Account"`
+	ARN     string `xml:"Arn"`
+	UserID  string `xml:"UserId"`
+}
+
+func (s *stsService) getCallerIdentity(context.Context, url.Values) (any, error) {
+	return &stsGetCallerIdentityResult{
+		Account: AccountID,
+		ARN:     globalARN("iam", "user/mockaws"),
+		UserID:  AccessKeyID,
+	}, nil
+}
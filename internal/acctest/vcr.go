@@ -9,13 +9,17 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -35,8 +39,9 @@ import (
 )
 
 const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
+	envVarVCRMode           = "VCR_MODE"
+	envVarVCRPath           = "VCR_PATH"
+	envVarVCRRedactionRules = "VCR_REDACTION_RULES"
 )
 
 type randomnessSource struct {
@@ -175,13 +180,17 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
+		redactor, err := newVCRRedactor()
 
-			return nil
-		}, recorder.AfterCaptureHook)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// Remove sensitive HTTP headers once captured.
+		// The provider receives the response built from the captured interaction,
+		// so sensitive values are only redacted before saving, once the account ID is known.
+		r.AddHook(redactor.stripHeaders, recorder.AfterCaptureHook)
+		r.AddHook(redactor.redactInteraction, recorder.BeforeSaveHook)
 
 		// Defines how VCR will match requests to responses.
 		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
@@ -203,56 +212,14 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			}
 
 			r.Body = io.NopCloser(&b)
-			body := b.String()
+			// Recorded request bodies have been redacted, so redact the request body in the same way.
+			body := redactor.redact(b.String())
 			// If body matches identically, we are done.
 			if body == i.Body {
 				return true
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
+			return vcrRequestBodiesMatch(ctx, r.Header.Get("Content-Type"), body, i.Body)
 		})
 
 		// Use the wrapped HTTP Client for AWS APIs.
@@ -273,6 +240,8 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
+		redactor.setAccountID(meta.AccountID(ctx))
+
 		// Don't retry requests if a recorded interaction isn't found.
 		// TODO Need to loop through all API clients to do this.
 		// TODO Use []*client.Client?
@@ -290,6 +259,104 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// vcrRequestBodiesMatch returns whether a request body is equivalent to a recorded request body.
+// Bodies are compared based on their AWS protocol's content type.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func vcrRequestBodiesMatch(ctx context.Context, contentType, requestBody, cassetteBody string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return false
+	}
+
+	var normalize func(string) (any, error)
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered.
+		normalize = func(s string) (any, error) {
+			var v any
+			err := json.Unmarshal([]byte(s), &v)
+			return v, err
+		}
+
+	case "application/x-www-form-urlencoded":
+		// Query protocol parameters might be the same, but reordered.
+		normalize = func(s string) (any, error) {
+			return url.ParseQuery(s)
+		}
+
+	case "application/xml", "text/xml":
+		// XML might be the same, but with different attribute order or whitespace.
+		normalize = func(s string) (any, error) {
+			return vcrNormalizeXML(s)
+		}
+
+	default:
+		return false
+	}
+
+	request, err := normalize(requestBody)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse request body", map[string]interface{}{
+			"content_type": mediaType,
+			"error":        err,
+		})
+		return false
+	}
+
+	recorded, err := normalize(cassetteBody)
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette body", map[string]interface{}{
+			"content_type": mediaType,
+			"error":        err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(request, recorded)
+}
+
+// vcrNormalizeXML returns a canonical form of an XML document.
+// Element order is significant, but attribute order, namespace prefixes, comments and insignificant whitespace are not.
+func vcrNormalizeXML(s string) (string, error) {
+	var sb strings.Builder
+	decoder := xml.NewDecoder(strings.NewReader(s))
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			attrs := make([]string, 0, len(token.Attr))
+			for _, attr := range token.Attr {
+				attrs = append(attrs, fmt.Sprintf("%s:%s=%q", attr.Name.Space, attr.Name.Local, attr.Value))
+			}
+			slices.Sort(attrs)
+
+			fmt.Fprintf(&sb, "<%s:%s %s>", token.Name.Space, token.Name.Local, strings.Join(attrs, " "))
+
+		case xml.EndElement:
+			fmt.Fprintf(&sb, "</%s:%s>", token.Name.Space, token.Name.Local)
+
+		case xml.CharData:
+			sb.WriteString(strings.TrimSpace(string(token)))
+		}
+	}
+
+	return sb.String(), nil
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -403,6 +470,13 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 				if err := v.Stop(); err != nil {
 					t.Error(err)
 				}
+
+				if v.Mode() == recorder.ModeRecordOnce {
+					path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName)) + ".yaml"
+					if err := CheckVCRCassette(path, meta.AccountID(ctx)); err != nil {
+						t.Error(err)
+					}
+				}
 			}
 		}
 
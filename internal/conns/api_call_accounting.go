@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+)
+
+const (
+	// Environment variable specifying the path of the file to which a summary of the AWS API calls made by the provider is written.
+	// A summary is appended to the file as a single line of JSON when the provider process exits.
+	APICallSummaryPathEnvVar = "TF_AWS_API_CALL_SUMMARY_PATH"
+)
+
+const (
+	apiCallResourceKindDataSource        = "data_source"
+	apiCallResourceKindEphemeralResource = "ephemeral_resource"
+	apiCallResourceKindResource          = "resource"
+)
+
+// apiCallAccountant is the provider process's API call accounting, or nil if API call accounting is disabled.
+var apiCallAccountant = sync.OnceValue(func() *apiCallAccounting {
+	if os.Getenv(APICallSummaryPathEnvVar) == "" {
+		return nil
+	}
+
+	return newAPICallAccounting()
+})
+
+// apiCallKey identifies the AWS API operation called by a resource type.
+type apiCallKey struct {
+	ResourceKind       string `json:"resource_kind,omitempty"`
+	ResourceType       string `json:"resource_type,omitempty"`
+	ServicePackageName string `json:"service_package,omitempty"`
+	ServiceID          string `json:"service_id"`
+	Operation          string `json:"operation"`
+}
+
+type apiCallStats struct {
+	apiCallKey
+
+	// Calls is the number of API calls, excluding retries.
+	Calls int `json:"calls"`
+	// Retries is the number of retried HTTP requests.
+	Retries int `json:"retries"`
+	// Errors is the number of HTTP requests that failed or returned an error status code.
+	Errors           int   `json:"errors"`
+	TotalLatencyMsec int64 `json:"total_latency_ms"`
+	MaxLatencyMsec   int64 `json:"max_latency_ms"`
+}
+
+// apiCallSummary is the machine-readable summary of the AWS API calls made by a provider process.
+type apiCallSummary struct {
+	PID       int             `json:"pid"`
+	Timestamp time.Time       `json:"timestamp"`
+	Calls     []*apiCallStats `json:"calls"`
+}
+
+// apiCallAccounting records the AWS API calls made by each resource type.
+// Calls are not recorded per resource instance: Terraform does not send a resource's address to the provider,
+// and a resource's ID is not known until it has been created.
+// A single instance is shared by all provider configurations in the process.
+type apiCallAccounting struct {
+	lock  sync.Mutex
+	stats map[apiCallKey]*apiCallStats
+}
+
+func newAPICallAccounting() *apiCallAccounting {
+	return &apiCallAccounting{
+		stats: make(map[apiCallKey]*apiCallStats),
+	}
+}
+
+// record records an HTTP request made for an API call.
+// attempt is the 1-based attempt number of the request, as set by the AWS SDK's retryer.
+func (a *apiCallAccounting) record(ctx context.Context, attempt int, latency time.Duration, failed bool) {
+	key := apiCallKey{
+		ServiceID: awsmiddleware.GetServiceID(ctx),
+		Operation: awsmiddleware.GetOperationName(ctx),
+	}
+	if inContext, ok := FromContext(ctx); ok {
+		key.ServicePackageName = inContext.ServicePackageName
+		key.ResourceType = inContext.ResourceName
+		switch {
+		case inContext.IsDataSource:
+			key.ResourceKind = apiCallResourceKindDataSource
+		case inContext.IsEphemeralResource:
+			key.ResourceKind = apiCallResourceKindEphemeralResource
+		default:
+			key.ResourceKind = apiCallResourceKindResource
+		}
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	stats, ok := a.stats[key]
+	if !ok {
+		stats = &apiCallStats{apiCallKey: key}
+		a.stats[key] = stats
+	}
+
+	if attempt > 1 {
+		stats.Retries++
+	} else {
+		stats.Calls++
+	}
+	if failed {
+		stats.Errors++
+	}
+	msec := latency.Milliseconds()
+	stats.TotalLatencyMsec += msec
+	stats.MaxLatencyMsec = max(stats.MaxLatencyMsec, msec)
+}
+
+// summary returns the summary of the recorded API calls, ordered by number of HTTP requests, most first.
+func (a *apiCallAccounting) summary() *apiCallSummary {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	calls := make([]*apiCallStats, 0, len(a.stats))
+	for _, v := range a.stats {
+		v := *v
+		calls = append(calls, &v)
+	}
+
+	slices.SortFunc(calls, func(x, y *apiCallStats) int {
+		return cmp.Or(
+			cmp.Compare(y.Calls+y.Retries, x.Calls+x.Retries),
+			cmp.Compare(x.ServicePackageName, y.ServicePackageName),
+			cmp.Compare(x.ResourceType, y.ResourceType),
+			cmp.Compare(x.ResourceKind, y.ResourceKind),
+			cmp.Compare(x.ServiceID, y.ServiceID),
+			cmp.Compare(x.Operation, y.Operation),
+		)
+	})
+
+	return &apiCallSummary{
+		PID:       os.Getpid(),
+		Timestamp: time.Now().UTC(),
+		Calls:     calls,
+	}
+}
+
+// accountingHTTPClient records the HTTP requests made by an AWS API client.
+type accountingHTTPClient struct {
+	aws.HTTPClient
+	accounting *apiCallAccounting
+}
+
+func (c *accountingHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := c.HTTPClient.Do(request)
+	latency := time.Since(start)
+
+	failed := err != nil || response.StatusCode >= http.StatusBadRequest
+	c.accounting.record(request.Context(), requestAttempt(request), latency, failed)
+
+	return response, err
+}
+
+// requestAttempt returns the attempt number from the AWS SDK's request metrics header, e.g. `attempt=2; max=25`.
+func requestAttempt(request *http.Request) int {
+	for _, part := range strings.Split(request.Header.Get("Amz-Sdk-Request"), ";") {
+		if v, ok := strings.CutPrefix(strings.TrimSpace(part), "attempt="); ok {
+			if n, err := strconv.Atoi(v); err == nil {
+				return n
+			}
+		}
+	}
+
+	return 1
+}
+
+// withAPICallAccounting returns the HTTP client with API call accounting applied, if enabled.
+func withAPICallAccounting(httpClient aws.HTTPClient) aws.HTTPClient {
+	accounting := apiCallAccountant()
+	if accounting == nil || httpClient == nil {
+		return httpClient
+	}
+
+	return &accountingHTTPClient{
+		HTTPClient: httpClient,
+		accounting: accounting,
+	}
+}
+
+// WriteAPICallSummary appends a summary of the AWS API calls made by the provider process to the file
+// specified by the TF_AWS_API_CALL_SUMMARY_PATH environment variable.
+// It does nothing if API call accounting is disabled.
+func WriteAPICallSummary() error {
+	accounting := apiCallAccountant()
+	if accounting == nil {
+		return nil
+	}
+
+	// Terraform runs multiple provider processes during an operation, so the summary is appended as JSON Lines.
+	b, err := json.Marshal(accounting.summary())
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	f, err := os.OpenFile(os.Getenv(APICallSummaryPathEnvVar), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRequestAttempt(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		header   string
+		expected int
+	}{
+		"no header": {
+			expected: 1,
+		},
+		"first attempt": {
+			header:   "attempt=1; max=25",
+			expected: 1,
+		},
+		"retry": {
+			header:   "attempt=3; max=25; ttl=20250102T030506Z",
+			expected: 3,
+		},
+		"invalid": {
+			header:   "attempt=x",
+			expected: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://example.com", nil)
+			if testCase.header != "" {
+				request.Header.Set("Amz-Sdk-Request", testCase.header)
+			}
+
+			if got, want := requestAttempt(request), testCase.expected; got != want {
+				t.Errorf("attempt = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestAPICallAccountingSummary(t *testing.T) {
+	t.Parallel()
+
+	ctx := awsmiddleware.SetServiceID(context.Background(), "EC2")
+	resourceCtx := NewResourceContext(ctx, "ec2", "Instance")
+	dataSourceCtx := NewDataSourceContext(ctx, "ec2", "Instance")
+
+	a := newAPICallAccounting()
+	a.record(ctx, 1, 10*time.Millisecond, false)
+	a.record(resourceCtx, 1, 20*time.Millisecond, false)
+	a.record(resourceCtx, 2, 30*time.Millisecond, true)
+	a.record(resourceCtx, 1, 40*time.Millisecond, false)
+	a.record(dataSourceCtx, 1, 50*time.Millisecond, false)
+
+	got := a.summary().Calls
+	want := []*apiCallStats{
+		{
+			apiCallKey: apiCallKey{
+				ResourceKind:       apiCallResourceKindResource,
+				ResourceType:       "Instance",
+				ServicePackageName: "ec2",
+				ServiceID:          "EC2",
+			},
+			Calls:            2,
+			Retries:          1,
+			Errors:           1,
+			TotalLatencyMsec: 90,
+			MaxLatencyMsec:   40,
+		},
+		{
+			apiCallKey: apiCallKey{
+				ServiceID: "EC2",
+			},
+			Calls:            1,
+			TotalLatencyMsec: 10,
+			MaxLatencyMsec:   10,
+		},
+		{
+			apiCallKey: apiCallKey{
+				ResourceKind:       apiCallResourceKindDataSource,
+				ResourceType:       "Instance",
+				ServicePackageName: "ec2",
+				ServiceID:          "EC2",
+			},
+			Calls:            1,
+			TotalLatencyMsec: 50,
+			MaxLatencyMsec:   50,
+		},
+	}
+
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(apiCallStats{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAccountingHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	a := newAPICallAccounting()
+	httpClient := &accountingHTTPClient{
+		HTTPClient: &testHTTPClient{},
+		accounting: a,
+	}
+
+	for _, attempt := range []string{"attempt=1", "attempt=2"} {
+		request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://example.com", nil)
+		request.Header.Set("Amz-Sdk-Request", attempt)
+
+		if _, err := httpClient.Do(request); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	calls := a.summary().Calls
+	if got, want := len(calls), 1; got != want {
+		t.Fatalf("len(calls) = %d, want %d", got, want)
+	}
+	if got, want := calls[0].Calls, 1; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+	if got, want := calls[0].Retries, 1; got != want {
+		t.Errorf("retries = %d, want %d", got, want)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
+	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -32,7 +33,9 @@ type AWSClient struct {
 	awsConfig                 *aws.Config
 	clients                   map[string]any
 	conns                     map[string]any
+	dataSourceCache           *dataSourceCache
 	defaultTagsConfig         *tftags.DefaultConfig
+	deletionGuardConfig       *tftags.DeletionGuardConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
@@ -44,6 +47,8 @@ type AWSClient struct {
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
+	serviceLimiters           map[string]*serviceLimiter
+	skipRegionValidation      bool   // From provider configuration.
 	stsRegion                 string // From provider configuration.
 }
 
@@ -55,16 +60,30 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the default tags configuration.
+// Within a resource's CRUD handlers, the configuration has been resolved for the resource type.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+
 	return c.defaultTagsConfig
 }
 
+func (c *AWSClient) DeletionGuardConfig(context.Context) *tftags.DeletionGuardConfig {
+	return c.deletionGuardConfig
+}
+
 func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+// AwsConfig returns a copy of the AWS SDK for Go v2 configuration.
+// Any per-resource Region override in effect is applied to the copy.
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	cfg.Region = c.Region(ctx)
+	return cfg
 }
 
 // AwsSession and Endpoints can be removed once the simpledb service is removed.
@@ -86,11 +105,49 @@ func (c *AWSClient) Partition(context.Context) string {
 	return c.partition.ID()
 }
 
-// Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// Region returns the ID of the AWS Region in effect.
+// This is the per-resource Region override, if any, otherwise the configured AWS Region.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		if v := inContext.OverrideRegion; v != "" {
+			return v
+		}
+	}
+
+	return c.region
+}
+
+// DefaultRegion returns the ID of the configured AWS Region, ignoring any per-resource Region override.
+func (c *AWSClient) DefaultRegion(context.Context) string {
 	return c.region
 }
 
+// ValidateInContextRegionInPartition verifies that any per-resource Region override
+// is a supported AWS Region and is in the configured AWS partition.
+func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) error {
+	inContext, ok := FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	region := inContext.OverrideRegion
+	if region == "" || region == c.region {
+		return nil
+	}
+
+	if !c.skipRegionValidation {
+		if err := basevalidation.SupportedRegion(region); err != nil {
+			return err
+		}
+	}
+
+	if partition := names.PartitionForRegion(region); partition.ID() != c.Partition(ctx) {
+		return fmt.Errorf("Region (%s) is not in the configured partition (%s)", region, c.Partition(ctx))
+	}
+
+	return nil
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -132,6 +189,17 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
 
+	// Clients for a per-resource Region override are not cached.
+	if c.Region(ctx) != c.region {
+		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
+			return errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+				"s3_us_east_1_regional_endpoint": "regional",
+			}))
+		}
+
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
@@ -291,8 +359,17 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	cfg := c.awsConfig
+	if region := c.Region(ctx); region != c.region {
+		v := c.awsConfig.Copy()
+		v.Region = region
+		cfg = &v
+	}
+	if v, ok := c.serviceLimiters[servicePackageName]; ok {
+		cfg = v.apply(cfg)
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": cfg,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -312,19 +389,29 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// clientCacheKey returns the key used to cache the default API client for the specified service in the AWS Region in effect.
+func (c *AWSClient) clientCacheKey(ctx context.Context, servicePackageName string) string {
+	if region := c.Region(ctx); region != c.region {
+		return servicePackageName + "@" + region
+	}
+
+	return servicePackageName
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per AWS Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.clientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -359,7 +446,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -99,6 +99,81 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestAWSClientRegionOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		OverrideRegion   string
+		ExpectedRegion   string
+		ExpectedARN      string
+		ExpectedHostname string
+	}{
+		{
+			Name:             "no override",
+			ExpectedRegion:   "us-west-2",                               //lintignore:AWSAT003
+			ExpectedARN:      "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			ExpectedHostname: "test.us-west-2.amazonaws.com",            //lintignore:AWSAT003
+		},
+		{
+			Name:             "override",
+			OverrideRegion:   "eu-central-1",                               //lintignore:AWSAT003
+			ExpectedRegion:   "eu-central-1",                               //lintignore:AWSAT003
+			ExpectedARN:      "arn:aws:sqs:eu-central-1:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			ExpectedHostname: "test.eu-central-1.amazonaws.com",            //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				accountID: "123456789012",
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			}
+			ctx := NewResourceContext(context.TODO(), "sqs", "Queue")
+			if inContext, ok := FromContext(ctx); ok {
+				inContext.OverrideRegion = testCase.OverrideRegion
+			}
+
+			if got, want := client.Region(ctx), testCase.ExpectedRegion; got != want {
+				t.Errorf("Region: got %s, expected %s", got, want)
+			}
+			if got, want := client.DefaultRegion(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+				t.Errorf("DefaultRegion: got %s, expected %s", got, want)
+			}
+			if got, want := client.RegionalARN(ctx, "sqs", "test"), testCase.ExpectedARN; got != want {
+				t.Errorf("RegionalARN: got %s, expected %s", got, want)
+			}
+			if got, want := client.RegionalHostname(ctx, "test"), testCase.ExpectedHostname; got != want {
+				t.Errorf("RegionalHostname: got %s, expected %s", got, want)
+			}
+			if err := client.ValidateInContextRegionInPartition(ctx); err != nil {
+				t.Errorf("ValidateInContextRegionInPartition: unexpected error %s", err)
+			}
+		})
+	}
+}
+
+func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		partition: standardPartition,
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+	ctx := NewResourceContext(context.TODO(), "sqs", "Queue")
+	if inContext, ok := FromContext(ctx); ok {
+		inContext.OverrideRegion = "cn-north-1" //lintignore:AWSAT003
+	}
+
+	if err := client.ValidateInContextRegionInPartition(ctx); err == nil {
+		t.Error("ValidateInContextRegionInPartition: expected error")
+	}
+}
+
 func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -29,10 +30,12 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIOptions                     []func(*middleware.Stack) error // Additional AWS SDK for Go v2 API middleware.
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DeletionGuardConfig            *tftags.DeletionGuardConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -50,9 +53,11 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceLimits                  map[string]ServiceLimits
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
+	SkipDataSourceCaching          bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
 	STSRegion                      string
@@ -180,6 +185,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// AWS API calls made by the AWS SDK for Go v2 are recorded if API call accounting is enabled.
+	cfg.HTTPClient = withAPICallAccounting(cfg.HTTPClient)
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partitionID, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -209,6 +218,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.deletionGuardConfig = c.DeletionGuardConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.region = c.Region
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
@@ -222,8 +232,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceLimiters = newServiceLimiters(c.ServiceLimits, c.TokenBucketRateLimiterCapacity, c.MaxRetries, maxBackoff)
+	client.skipRegionValidation = c.SkipRegionValidation
 	client.stsRegion = c.STSRegion
 
+	if !c.SkipDataSourceCaching {
+		client.dataSourceCache = newDataSourceCache()
+	}
+
 	return client, diags
 }
 
@@ -26,6 +26,13 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithSDKResourceStateMovers is an interface that extends ServicePackage with state moves to Plugin SDK resources.
+// Plugin SDK resources do not support moving resource state across resource types, so the moves are handled by the provider.
+type ServicePackageWithSDKResourceStateMovers interface {
+	ServicePackage
+	SDKResourceStateMovers(context.Context) []*types.ServicePackageSDKResourceStateMover
+}
+
 type (
 	contextKeyType int
 )
@@ -38,6 +45,7 @@ var (
 type InContext struct {
 	IsDataSource        bool   // Data source?
 	IsEphemeralResource bool   // Ephemeral resource?
+	OverrideRegion      string // Per-resource Region override, set from the top-level `region` attribute
 	ResourceName        string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName  string // Canonical name defined as a constant in names package
 }
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// dataSourceCache memoizes the results of reading idempotent data sources.
+// Results are cached for the lifetime of the provider instance, i.e. a single Terraform operation.
+type dataSourceCache struct {
+	lock    sync.Mutex
+	entries map[dataSourceCacheKey]*dataSourceCacheEntry
+}
+
+type dataSourceCacheKey struct {
+	arguments string
+	region    string
+	typeName  string
+}
+
+type dataSourceCacheEntry struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+func newDataSourceCache() *dataSourceCache {
+	return &dataSourceCache{
+		entries: make(map[dataSourceCacheKey]*dataSourceCacheEntry),
+	}
+}
+
+// ReadDataSourceCached returns the result of reading the data source with the specified type name and arguments.
+// arguments must uniquely identify the data source's configuration, e.g. the string representation of the raw configuration value.
+// read is called once for each distinct type name, arguments and AWS Region in effect; results that are errors are not cached.
+// read is called every time if data source caching is disabled.
+func ReadDataSourceCached[T any](ctx context.Context, c *AWSClient, typeName, arguments string, read func(context.Context) (T, error)) (T, error) {
+	cache := c.dataSourceCache
+	if cache == nil {
+		return read(ctx)
+	}
+
+	key := dataSourceCacheKey{
+		arguments: arguments,
+		region:    c.Region(ctx),
+		typeName:  typeName,
+	}
+	fields := map[string]any{
+		"tf_aws.data_source_cache.type_name": typeName,
+		"tf_aws.data_source_cache.region":    key.region,
+	}
+
+	cache.lock.Lock()
+	entry, ok := cache.entries[key]
+	if !ok {
+		entry = &dataSourceCacheEntry{
+			done: make(chan struct{}),
+		}
+		cache.entries[key] = entry
+	}
+	cache.lock.Unlock()
+
+	if ok {
+		// Wait for any in-progress read.
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+
+		if entry.err == nil {
+			tflog.Debug(ctx, "Data source cache hit", fields)
+
+			return entry.value.(T), nil
+		}
+
+		// The in-progress read failed.
+		tflog.Debug(ctx, "Data source cache miss", fields)
+
+		return read(ctx)
+	}
+
+	tflog.Debug(ctx, "Data source cache miss", fields)
+
+	value, err := read(ctx)
+
+	entry.value, entry.err = value, err
+	if err != nil {
+		cache.lock.Lock()
+		delete(cache.entries, key)
+		cache.lock.Unlock()
+	}
+	close(entry.done)
+
+	return value, err
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestReadDataSourceCached(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		dataSourceCache: newDataSourceCache(),
+		region:          "us-west-2", //lintignore:AWSAT003
+	}
+
+	var reads atomic.Int32
+	read := func(v string) func(context.Context) (string, error) {
+		return func(context.Context) (string, error) {
+			reads.Add(1)
+			return v, nil
+		}
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			got, err := ReadDataSourceCached(ctx, client, "aws_test", "arguments1", read("value1"))
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if want := "value1"; got != want {
+				t.Errorf("value = %q, want %q", got, want)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := reads.Load(), int32(1); got != want {
+		t.Errorf("reads = %d, want %d", got, want)
+	}
+
+	// Different arguments.
+	got, err := ReadDataSourceCached(ctx, client, "aws_test", "arguments2", read("value2"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := "value2"; got != want {
+		t.Errorf("value = %q, want %q", got, want)
+	}
+
+	// Different type name.
+	if _, err := ReadDataSourceCached(ctx, client, "aws_test2", "arguments1", read("value1")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Different Region.
+	regionCtx := NewDataSourceContext(ctx, "test", "Test")
+	if inContext, ok := FromContext(regionCtx); ok {
+		inContext.OverrideRegion = "us-east-1" //lintignore:AWSAT003
+	}
+	if _, err := ReadDataSourceCached(regionCtx, client, "aws_test", "arguments1", read("value1")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := reads.Load(), int32(4); got != want {
+		t.Errorf("reads = %d, want %d", got, want)
+	}
+}
+
+func TestReadDataSourceCachedError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		dataSourceCache: newDataSourceCache(),
+	}
+
+	var reads atomic.Int32
+	readErr := errors.New("read error")
+	read := func(context.Context) (string, error) {
+		if reads.Add(1) == 1 {
+			return "", readErr
+		}
+		return "value", nil
+	}
+
+	if _, err := ReadDataSourceCached(ctx, client, "aws_test", "arguments", read); !errors.Is(err, readErr) {
+		t.Errorf("expected read error, got %v", err)
+	}
+
+	// Errors are not cached.
+	for range 2 {
+		got, err := ReadDataSourceCached(ctx, client, "aws_test", "arguments", read)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if want := "value"; got != want {
+			t.Errorf("value = %q, want %q", got, want)
+		}
+	}
+
+	if got, want := reads.Load(), int32(2); got != want {
+		t.Errorf("reads = %d, want %d", got, want)
+	}
+}
+
+func TestReadDataSourceCachedDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{}
+
+	var reads atomic.Int32
+	read := func(context.Context) (string, error) {
+		reads.Add(1)
+		return "value", nil
+	}
+
+	for range 2 {
+		if _, err := ReadDataSourceCached(ctx, client, "aws_test", "arguments", read); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := reads.Load(), int32(2); got != want {
+		t.Errorf("reads = %d, want %d", got, want)
+	}
+}
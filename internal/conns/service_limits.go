@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+)
+
+// ServiceLimits contains the AWS API request limits for a single service.
+// Zero values indicate that the provider-wide configuration is used.
+type ServiceLimits struct {
+	// MaxConcurrentRequests is the maximum number of in-flight requests to the service's API.
+	MaxConcurrentRequests int
+	// MaxRetries is the maximum number of times a request to the service's API is attempted.
+	MaxRetries int
+	// RequestsPerSecond is the maximum rate of requests to the service's API.
+	RequestsPerSecond float64
+	// RetryMode specifies how requests to the service's API are retried.
+	RetryMode aws.RetryMode
+}
+
+// serviceLimiter applies a service's limits to the configuration of its AWS API clients.
+// A single limiter is shared by all of the service's API clients, including those for per-resource Region overrides.
+type serviceLimiter struct {
+	limits          ServiceLimits
+	rateLimiter     *requestRateLimiter
+	semaphore       chan struct{}
+	standardOptions []func(*retry.StandardOptions)
+}
+
+// newServiceLimiter returns a limiter for the specified limits.
+// standardOptions are used to construct the service's retryer if the retry mode is overridden.
+func newServiceLimiter(limits ServiceLimits, standardOptions ...func(*retry.StandardOptions)) *serviceLimiter {
+	l := &serviceLimiter{
+		limits:          limits,
+		standardOptions: standardOptions,
+	}
+
+	if v := limits.MaxConcurrentRequests; v > 0 {
+		l.semaphore = make(chan struct{}, v)
+	}
+
+	if v := limits.RequestsPerSecond; v > 0 {
+		l.rateLimiter = &requestRateLimiter{
+			interval: time.Duration(float64(time.Second) / v),
+		}
+	}
+
+	return l
+}
+
+// newServiceLimiters returns limiters for the specified services' limits, keyed by service package name.
+func newServiceLimiters(serviceLimits map[string]ServiceLimits, tokenBucketRateLimiterCapacity, maxRetries int, maxBackoff time.Duration) map[string]*serviceLimiter {
+	if len(serviceLimits) == 0 {
+		return nil
+	}
+
+	// Options for any retryer constructed with an overridden retry mode match those of the provider-wide retryer.
+	standardOptions := []func(*retry.StandardOptions){
+		func(o *retry.StandardOptions) {
+			o.Backoff = &v1CompatibleBackoff{maxRetryDelay: maxBackoff}
+			o.MaxBackoff = maxBackoff
+			if maxRetries > 0 {
+				o.MaxAttempts = maxRetries
+			}
+			if tokenBucketRateLimiterCapacity > 0 {
+				o.RateLimiter = ratelimit.NewTokenRateLimit(uint(tokenBucketRateLimiterCapacity))
+			}
+		},
+	}
+
+	limiters := make(map[string]*serviceLimiter, len(serviceLimits))
+
+	for servicePackageName, limits := range serviceLimits {
+		limiters[servicePackageName] = newServiceLimiter(limits, standardOptions...)
+	}
+
+	return limiters
+}
+
+// apply returns a copy of the AWS SDK for Go v2 configuration with the service's limits applied.
+func (l *serviceLimiter) apply(cfg *aws.Config) *aws.Config {
+	v := cfg.Copy()
+
+	if l.rateLimiter != nil || l.semaphore != nil {
+		httpClient := v.HTTPClient
+		if httpClient == nil {
+			httpClient = awshttp.NewBuildableClient()
+		}
+
+		v.HTTPClient = &limitedHTTPClient{
+			HTTPClient:  httpClient,
+			rateLimiter: l.rateLimiter,
+			semaphore:   l.semaphore,
+		}
+	}
+
+	if mode := l.limits.RetryMode; mode != "" {
+		v.RetryMode = mode
+		v.Retryer = func() aws.Retryer {
+			switch mode {
+			case aws.RetryModeAdaptive:
+				return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+					o.StandardOptions = append(o.StandardOptions, l.standardOptions...)
+				})
+			default:
+				return retry.NewStandard(l.standardOptions...)
+			}
+		}
+	}
+
+	// The API client wraps its retryer with the maximum number of attempts.
+	if n := l.limits.MaxRetries; n > 0 {
+		v.RetryMaxAttempts = n
+	}
+
+	return &v
+}
+
+// limitedHTTPClient limits the rate and concurrency of the HTTP requests made by an AWS API client.
+// Each attempt of a retried API call is a separate HTTP request.
+type limitedHTTPClient struct {
+	aws.HTTPClient
+	rateLimiter *requestRateLimiter
+	semaphore   chan struct{}
+}
+
+func (c *limitedHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	if c.semaphore != nil {
+		select {
+		case c.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		defer func() { <-c.semaphore }()
+	}
+
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.HTTPClient.Do(request)
+}
+
+// requestRateLimiter spaces requests evenly at a fixed interval.
+type requestRateLimiter struct {
+	interval time.Duration
+	lock     sync.Mutex
+	next     time.Time
+}
+
+// wait blocks until the next request is permitted or the Context is done.
+func (l *requestRateLimiter) wait(ctx context.Context) error {
+	l.lock.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.lock.Unlock()
+
+	delay := at.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
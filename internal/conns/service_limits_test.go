@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+type testHTTPClient struct {
+	delay       time.Duration
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (c *testHTTPClient) Do(*http.Request) (*http.Response, error) {
+	n := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+
+	for {
+		if v := c.maxInFlight.Load(); n <= v || c.maxInFlight.CompareAndSwap(v, n) {
+			break
+		}
+	}
+
+	time.Sleep(c.delay)
+
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestServiceLimiterApply(t *testing.T) {
+	t.Parallel()
+
+	retryer := func() aws.Retryer {
+		return retry.NewStandard()
+	}
+	cfg := &aws.Config{
+		HTTPClient:       &testHTTPClient{},
+		RetryMaxAttempts: 25,
+		Retryer:          retryer,
+	}
+
+	testCases := []struct {
+		name                      string
+		limits                    ServiceLimits
+		expectLimitedHTTPClient   bool
+		expectRetryMaxAttempts    int
+		expectRetryMode           aws.RetryMode
+		expectAdaptiveModeRetryer bool
+	}{
+		{
+			name:                   "no limits",
+			expectRetryMaxAttempts: 25,
+		},
+		{
+			name: "max concurrent requests",
+			limits: ServiceLimits{
+				MaxConcurrentRequests: 2,
+			},
+			expectLimitedHTTPClient: true,
+			expectRetryMaxAttempts:  25,
+		},
+		{
+			name: "requests per second",
+			limits: ServiceLimits{
+				RequestsPerSecond: 0.5,
+			},
+			expectLimitedHTTPClient: true,
+			expectRetryMaxAttempts:  25,
+		},
+		{
+			name: "max retries",
+			limits: ServiceLimits{
+				MaxRetries: 5,
+			},
+			expectRetryMaxAttempts: 5,
+		},
+		{
+			name: "adaptive retry mode",
+			limits: ServiceLimits{
+				RetryMode: aws.RetryModeAdaptive,
+			},
+			expectRetryMaxAttempts:    25,
+			expectRetryMode:           aws.RetryModeAdaptive,
+			expectAdaptiveModeRetryer: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := newServiceLimiter(testCase.limits).apply(cfg)
+
+			if got == cfg {
+				t.Fatal("configuration not copied")
+			}
+
+			if _, ok := got.HTTPClient.(*limitedHTTPClient); ok != testCase.expectLimitedHTTPClient {
+				t.Errorf("limited HTTP client = %t, want %t", ok, testCase.expectLimitedHTTPClient)
+			}
+
+			if got, want := got.RetryMaxAttempts, testCase.expectRetryMaxAttempts; got != want {
+				t.Errorf("RetryMaxAttempts = %d, want %d", got, want)
+			}
+
+			if got, want := got.RetryMode, testCase.expectRetryMode; got != want {
+				t.Errorf("RetryMode = %q, want %q", got, want)
+			}
+
+			if _, ok := got.Retryer().(*retry.AdaptiveMode); ok != testCase.expectAdaptiveModeRetryer {
+				t.Errorf("adaptive mode retryer = %t, want %t", ok, testCase.expectAdaptiveModeRetryer)
+			}
+		})
+	}
+
+	if _, ok := cfg.HTTPClient.(*testHTTPClient); !ok {
+		t.Errorf("original configuration modified")
+	}
+}
+
+func TestLimitedHTTPClientMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxConcurrentRequests = 2
+		n                     = 10
+	)
+
+	httpClient := &testHTTPClient{delay: 10 * time.Millisecond}
+	cfg := newServiceLimiter(ServiceLimits{MaxConcurrentRequests: maxConcurrentRequests}).apply(&aws.Config{HTTPClient: httpClient})
+
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://example.com", nil)
+			if _, err := cfg.HTTPClient.Do(request); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := httpClient.maxInFlight.Load(), int32(maxConcurrentRequests); got > want {
+		t.Errorf("maximum in-flight requests = %d, want at most %d", got, want)
+	}
+}
+
+func TestRequestRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := &requestRateLimiter{interval: 20 * time.Millisecond}
+
+	start := time.Now()
+	for range 4 {
+		if err := l.wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// The first request is not delayed.
+	if got, want := time.Since(start), 60*time.Millisecond; got < want {
+		t.Errorf("elapsed = %s, want at least %s", got, want)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	l = &requestRateLimiter{interval: time.Hour}
+	if err := l.wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := l.wait(ctx); err == nil {
+		t.Error("expected error, got none")
+	}
+}
@@ -12,7 +12,17 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 	client.defaultTagsConfig = d
 }
 
+// SetDeletionGuardConfig is only intended for use in tests
+func SetDeletionGuardConfig(client *AWSClient, dg *tftags.DeletionGuardConfig) {
+	client.deletionGuardConfig = dg
+}
+
 // SetIgnoreTagsConfig is only intended for use in tests
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetRegion is only intended for use in tests
+func SetRegion(client *AWSClient, region string) {
+	client.region = region
+}
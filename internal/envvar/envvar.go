@@ -66,6 +66,9 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For running acceptance tests against an in-process fake AWS backend instead of AWS
+	AccMockAWS = "TF_ACC_MOCK_AWS"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -84,6 +87,25 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to preview and filter resource sweepers
+const (
+	// If true, sweepers list the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Only resources created at least this long ago, e.g. 24h, are swept
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Only resources whose name, or ID if the resource has no name, matches this regular expression are swept
+	SweepNameRegex = "TF_AWS_SWEEP_NAME_REGEX"
+
+	// Path of the file to which a JSON report of the sweep is written
+	SweepReportPath = "TF_AWS_SWEEP_REPORT_PATH"
+
+	// Only resources with all of these comma-separated tags, e.g. Owner=test,Temporary, are swept.
+	// A tag without a value matches any value
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
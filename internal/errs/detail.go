@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	smithy "github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var (
+	encodedAuthorizationMessageRegexp = regexp.MustCompile(`Encoded authorization failure message: ([A-Za-z0-9_-]+)`)
+	notAuthorizedActionRegexp         = regexp.MustCompile(`not authorized to perform: ([A-Za-z0-9-]+:[A-Za-z0-9]+)`)
+)
+
+// Error codes returned when a request is throttled.
+var throttlingErrorCodes = []string{
+	"EC2ThrottledException",
+	"PriorRequestNotComplete",
+	"ProvisionedThroughputExceededException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestThrottledException",
+	"SlowDown",
+	"ThrottledException",
+	"Throttling",
+	"ThrottlingException",
+	"TooManyRequestsException",
+}
+
+// Error codes returned when the caller does not have the required permissions.
+var accessDeniedErrorCodes = []string{
+	"AccessDenied",
+	"AccessDeniedException",
+	"AuthorizationError",
+	"AuthorizationErrorException",
+	"Forbidden",
+	"UnauthorizedOperation",
+}
+
+// Error codes returned when a KMS key used by a service is disabled or pending deletion.
+var kmsKeyDisabledErrorCodes = []string{
+	"KMS.DisabledException",
+	"KMS.KMSInvalidStateException",
+	"KMSDisabledException",
+	"KMSInvalidStateException",
+	"KmsDisabledException",
+}
+
+// ErrorDetail returns diagnostic detail for the specified AWS API error.
+// The detail includes the API operation, HTTP status code, request ID and error code, and a remediation hint for common errors.
+// Returns an empty string if err is not an AWS API error.
+func ErrorDetail(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var lines []string
+
+	if v, ok := As[interface {
+		error
+		Service() string
+		Operation() string
+	}](err); ok {
+		lines = append(lines, fmt.Sprintf("Operation: %s %s", v.Service(), v.Operation()))
+	}
+	if v, ok := As[interface {
+		error
+		HTTPStatusCode() int
+	}](err); ok {
+		lines = append(lines, fmt.Sprintf("HTTP status code: %d", v.HTTPStatusCode()))
+	}
+	if v, ok := As[interface {
+		error
+		ServiceRequestID() string
+	}](err); ok && v.ServiceRequestID() != "" {
+		lines = append(lines, "Request ID: "+v.ServiceRequestID())
+	}
+
+	apiErr, ok := As[smithy.APIError](err)
+	if ok {
+		lines = append(lines, "Error code: "+apiErr.ErrorCode())
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+
+	detail := strings.Join(lines, "\n")
+
+	if ok {
+		if hint := errorHint(err, apiErr); hint != "" {
+			detail += "\n\n" + hint
+		}
+	}
+
+	return detail
+}
+
+// errorHint returns a remediation hint for the specified AWS API error, or an empty string if there is none.
+func errorHint(err error, apiErr smithy.APIError) string {
+	code, message := apiErr.ErrorCode(), apiErr.ErrorMessage()
+
+	switch {
+	case slices.Contains(throttlingErrorCodes, code):
+		return "The request was throttled by AWS. " +
+			"Reduce the number of concurrent operations, e.g. with Terraform's -parallelism option, " +
+			"or limit the rate of requests to the service with the provider's service_limits configuration block."
+
+	case slices.Contains(accessDeniedErrorCodes, code):
+		var hint string
+		if m := notAuthorizedActionRegexp.FindStringSubmatch(message); m != nil {
+			hint = fmt.Sprintf("The caller is missing the IAM permission %q. ", m[1])
+		}
+		switch {
+		case strings.Contains(message, "service control policy"):
+			hint += "The action is denied by an AWS Organizations service control policy (SCP). " +
+				"IAM policies in the account cannot override an SCP; contact your organization's administrator."
+		case strings.Contains(message, "explicit deny"):
+			hint += "The action is explicitly denied by a policy. Remove or update the policy statement that denies it."
+		default:
+			hint += "Grant the caller the required permissions in an identity-based or resource-based policy."
+		}
+		if encodedAuthorizationMessageRegexp.MatchString(message) {
+			hint += " The encoded authorization failure message can be decoded with the STS DecodeAuthorizationMessage API, " +
+				"which requires the sts:DecodeAuthorizationMessage permission."
+		}
+		return hint
+
+	case slices.Contains(kmsKeyDisabledErrorCodes, code), code == "DisabledException" && isKMSError(err):
+		return "The AWS KMS key used for encryption is disabled or pending deletion. " +
+			"Enable the key or cancel its deletion, or use a different key."
+	}
+
+	return ""
+}
+
+// isKMSError returns whether the specified error was returned by the AWS KMS API.
+func isKMSError(err error) bool {
+	v, ok := As[interface {
+		error
+		Service() string
+	}](err)
+
+	return ok && v.Service() == "KMS"
+}
+
+// AuthorizationMessageDecoder decodes an encoded authorization failure message, e.g. using the STS DecodeAuthorizationMessage API.
+type AuthorizationMessageDecoder func(ctx context.Context, encodedMessage string) (string, error)
+
+// DecodeAuthorizationMessages returns the diagnostics with the decoded form of any encoded authorization failure message
+// in an error's summary or detail appended to the detail.
+// Messages that cannot be decoded are left unchanged.
+func DecodeAuthorizationMessages(ctx context.Context, diags diag.Diagnostics, decode AuthorizationMessageDecoder) diag.Diagnostics {
+	diags = slices.Clone(diags)
+
+	for i, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		if v, ok := DecodeAuthorizationMessage(ctx, d.Summary, d.Detail, decode); ok {
+			d.Detail = v
+			diags[i] = d
+		}
+	}
+
+	return diags
+}
+
+// DecodeAuthorizationMessage returns the specified detail with the decoded form of any encoded authorization failure message
+// in the summary or detail appended.
+// Returns false if there is no encoded message or it cannot be decoded.
+func DecodeAuthorizationMessage(ctx context.Context, summary, detail string, decode AuthorizationMessageDecoder) (string, bool) {
+	m := encodedAuthorizationMessageRegexp.FindStringSubmatch(summary)
+	if m == nil {
+		m = encodedAuthorizationMessageRegexp.FindStringSubmatch(detail)
+	}
+	if m == nil {
+		return detail, false
+	}
+
+	decoded, err := decode(ctx, m[1])
+	if err != nil {
+		return detail, false
+	}
+
+	// The decoded message is a JSON document.
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(decoded), "", "  "); err == nil {
+		decoded = buf.String()
+	}
+
+	if detail != "" {
+		detail += "\n\n"
+	}
+	detail += "Decoded authorization failure message:\n" + decoded
+
+	return detail, true
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func operationError(serviceID, operation string, statusCode int, requestID string, err error) error {
+	return &smithy.OperationError{
+		ServiceID:     serviceID,
+		OperationName: operation,
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{
+					Response: &http.Response{StatusCode: statusCode},
+				},
+				Err: err,
+			},
+			RequestID: requestID,
+		},
+	}
+}
+
+func TestErrorDetail(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected string
+	}{
+		"nil": {},
+		"not an AWS API error": {
+			err: errors.New("test"),
+		},
+		"API error": {
+			err: operationError("EC2", "DescribeVpcs", http.StatusBadRequest, "1234", errs.APIError("InvalidVpcID.NotFound", "not found")),
+			expected: `Operation: EC2 DescribeVpcs
+HTTP status code: 400
+Request ID: 1234
+Error code: InvalidVpcID.NotFound`,
+		},
+		"wrapped API error": {
+			err: fmt.Errorf("reading EC2 VPC (%s): %w", "vpc-12345678", operationError("EC2", "DescribeVpcs", http.StatusBadRequest, "1234", errs.APIError("InvalidVpcID.NotFound", "not found"))),
+			expected: `Operation: EC2 DescribeVpcs
+HTTP status code: 400
+Request ID: 1234
+Error code: InvalidVpcID.NotFound`,
+		},
+		"throttling": {
+			err: operationError("SSM", "GetParameter", http.StatusBadRequest, "1234", errs.APIError("ThrottlingException", "Rate exceeded")),
+			expected: `Operation: SSM GetParameter
+HTTP status code: 400
+Request ID: 1234
+Error code: ThrottlingException
+
+The request was throttled by AWS. Reduce the number of concurrent operations, e.g. with Terraform's -parallelism option, or limit the rate of requests to the service with the provider's service_limits configuration block.`,
+		},
+		"access denied": {
+			err: operationError("IAM", "GetRole", http.StatusForbidden, "1234", errs.APIError("AccessDenied", "User: arn:aws:iam::123456789012:user/test is not authorized to perform: iam:GetRole on resource: role test")),
+			expected: `Operation: IAM GetRole
+HTTP status code: 403
+Request ID: 1234
+Error code: AccessDenied
+
+The caller is missing the IAM permission "iam:GetRole". Grant the caller the required permissions in an identity-based or resource-based policy.`,
+		},
+		"access denied by SCP": {
+			err: operationError("S3", "CreateBucket", http.StatusForbidden, "1234", errs.APIError("AccessDenied", "User: arn:aws:iam::123456789012:user/test is not authorized to perform: s3:CreateBucket with an explicit deny in a service control policy")),
+			expected: `Operation: S3 CreateBucket
+HTTP status code: 403
+Request ID: 1234
+Error code: AccessDenied
+
+The caller is missing the IAM permission "s3:CreateBucket". The action is denied by an AWS Organizations service control policy (SCP). IAM policies in the account cannot override an SCP; contact your organization's administrator.`,
+		},
+		"unauthorized operation with encoded message": {
+			err: operationError("EC2", "RunInstances", http.StatusForbidden, "1234", errs.APIError("UnauthorizedOperation", "You are not authorized to perform this operation. Encoded authorization failure message: abc-123_XYZ")),
+			expected: `Operation: EC2 RunInstances
+HTTP status code: 403
+Request ID: 1234
+Error code: UnauthorizedOperation
+
+Grant the caller the required permissions in an identity-based or resource-based policy. The encoded authorization failure message can be decoded with the STS DecodeAuthorizationMessage API, which requires the sts:DecodeAuthorizationMessage permission.`,
+		},
+		"KMS key disabled": {
+			err: operationError("KMS", "Encrypt", http.StatusBadRequest, "1234", errs.APIError("DisabledException", "key is disabled")),
+			expected: `Operation: KMS Encrypt
+HTTP status code: 400
+Request ID: 1234
+Error code: DisabledException
+
+The AWS KMS key used for encryption is disabled or pending deletion. Enable the key or cancel its deletion, or use a different key.`,
+		},
+		"non-KMS disabled": {
+			err: operationError("Lambda", "Invoke", http.StatusBadRequest, "1234", errs.APIError("DisabledException", "disabled")),
+			expected: `Operation: Lambda Invoke
+HTTP status code: 400
+Request ID: 1234
+Error code: DisabledException`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(errs.ErrorDetail(testCase.err), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDecodeAuthorizationMessages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	decode := func(_ context.Context, encodedMessage string) (string, error) {
+		if encodedMessage == "invalid" {
+			return "", errors.New("invalid message")
+		}
+		return `{"allowed":false,"context":{"action":"ec2:RunInstances"}}`, nil
+	}
+
+	diags := diag.Diagnostics{
+		errs.NewWarningDiagnostic("Encoded authorization failure message: abc", ""),
+		errs.NewErrorDiagnostic("creating EC2 Instance: Encoded authorization failure message: abc", "Error code: UnauthorizedOperation"),
+		errs.NewErrorDiagnostic("creating EC2 Instance", "Encoded authorization failure message: invalid"),
+		errs.NewErrorDiagnostic("creating EC2 Instance", ""),
+	}
+
+	got := errs.DecodeAuthorizationMessages(ctx, diags, decode)
+	want := diag.Diagnostics{
+		diags[0],
+		errs.NewErrorDiagnostic("creating EC2 Instance: Encoded authorization failure message: abc", `Error code: UnauthorizedOperation
+
+Decoded authorization failure message:
+{
+  "allowed": false,
+  "context": {
+    "action": "ec2:RunInstances"
+  }
+}`),
+		diags[2],
+		diags[3],
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
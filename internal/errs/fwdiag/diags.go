@@ -4,11 +4,14 @@
 package fwdiag
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
 // DiagnosticsError returns an error containing all Diagnostic with SeverityError
@@ -59,3 +62,29 @@ func DiagnosticsString(diags diag.Diagnostics) string {
 
 	return buf.String()
 }
+
+// DecodeAuthorizationMessages returns the diagnostics with the decoded form of any encoded authorization failure message
+// in an error's summary or detail appended to the detail.
+// Messages that cannot be decoded are left unchanged.
+func DecodeAuthorizationMessages(ctx context.Context, diags diag.Diagnostics, decode errs.AuthorizationMessageDecoder) diag.Diagnostics {
+	diags = slices.Clone(diags)
+
+	for i, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			continue
+		}
+
+		detail, ok := errs.DecodeAuthorizationMessage(ctx, d.Summary(), d.Detail(), decode)
+		if !ok {
+			continue
+		}
+
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			diags[i] = diag.NewAttributeErrorDiagnostic(withPath.Path(), d.Summary(), detail)
+		} else {
+			diags[i] = diag.NewErrorDiagnostic(d.Summary(), detail)
+		}
+	}
+
+	return diags
+}
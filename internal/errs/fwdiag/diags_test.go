@@ -4,9 +4,12 @@
 package fwdiag_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 )
 
@@ -68,3 +71,52 @@ func TestDiagnosticsError(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeAuthorizationMessages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	decode := func(_ context.Context, encodedMessage string) (string, error) {
+		if encodedMessage == "invalid" {
+			return "", errors.New("invalid message")
+		}
+		return `{"allowed":false,"context":{"action":"ec2:RunInstances"}}`, nil
+	}
+
+	diags := diag.Diagnostics{
+		diag.NewWarningDiagnostic("Encoded authorization failure message: abc", ""),
+		diag.NewErrorDiagnostic("creating EC2 Instance: Encoded authorization failure message: abc", "Error code: UnauthorizedOperation"),
+		diag.NewAttributeErrorDiagnostic(path.Root("name"), "creating EC2 Instance", "Encoded authorization failure message: abc"),
+		diag.NewErrorDiagnostic("creating EC2 Instance", "Encoded authorization failure message: invalid"),
+		diag.NewErrorDiagnostic("creating EC2 Instance", ""),
+	}
+
+	got := fwdiag.DecodeAuthorizationMessages(ctx, diags, decode)
+	want := diag.Diagnostics{
+		diags[0],
+		diag.NewErrorDiagnostic("creating EC2 Instance: Encoded authorization failure message: abc", `Error code: UnauthorizedOperation
+
+Decoded authorization failure message:
+{
+  "allowed": false,
+  "context": {
+    "action": "ec2:RunInstances"
+  }
+}`),
+		diag.NewAttributeErrorDiagnostic(path.Root("name"), "creating EC2 Instance", `Encoded authorization failure message: abc
+
+Decoded authorization failure message:
+{
+  "allowed": false,
+  "context": {
+    "action": "ec2:RunInstances"
+  }
+}`),
+		diags[3],
+		diags[4],
+	}
+
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
@@ -17,15 +18,19 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error diagnostic with the formatted summary.
+// If any of the arguments is an AWS API error, the diagnostic's detail describes the error.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, errs.NewErrorDiagnostic(fmt.Sprintf(format, a...), errs.ErrorDetail(lastError(a))))
 }
 
+// AppendFromErr appends an error diagnostic for the specified error.
+// If the error is an AWS API error, the diagnostic's detail describes the error.
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+	return append(diags, errs.NewErrorDiagnostic(err.Error(), errs.ErrorDetail(err)))
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
@@ -43,3 +48,14 @@ func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics
 		}
 	})
 }
+
+// lastError returns the last of the specified values that is an error, or nil if there is none.
+func lastError(a []any) error {
+	for i := len(a) - 1; i >= 0; i-- {
+		if err, ok := a[i].(error); ok {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// NewWarningsContext returns a Context that collects warnings added by AddWarningToContext.
+// Plugin SDK functions such as CustomizeDiff can only return errors.
+func NewWarningsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, warningsKey, &diag.Diagnostics{})
+}
+
+// AddWarningToContext adds a warning to those collected in the Context.
+// The warning is discarded if the Context does not collect warnings.
+func AddWarningToContext(ctx context.Context, summary, detail string) {
+	if v, ok := ctx.Value(warningsKey).(*diag.Diagnostics); ok {
+		*v = append(*v, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   detail,
+		})
+	}
+}
+
+// WarningsFromContext returns the warnings collected in the Context.
+func WarningsFromContext(ctx context.Context) diag.Diagnostics {
+	if v, ok := ctx.Value(warningsKey).(*diag.Diagnostics); ok {
+		return *v
+	}
+
+	return nil
+}
+
+type keyType int
+
+var warningsKey keyType
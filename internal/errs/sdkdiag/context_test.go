@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestWarningsContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	sdkdiag.AddWarningToContext(ctx, "discarded", "")
+	if got := sdkdiag.WarningsFromContext(ctx); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+
+	ctx = sdkdiag.NewWarningsContext(ctx)
+	sdkdiag.AddWarningToContext(ctx, "summary1", "detail1")
+	sdkdiag.AddWarningToContext(ctx, "summary2", "detail2")
+
+	want := diag.Diagnostics{
+		diag.Diagnostic{Severity: diag.Warning, Summary: "summary1", Detail: "detail1"},
+		diag.Diagnostic{Severity: diag.Warning, Summary: "summary2", Detail: "detail2"},
+	}
+	if got := sdkdiag.WarningsFromContext(ctx); !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
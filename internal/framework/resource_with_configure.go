@@ -52,6 +52,18 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+
+			// Show which default_tags rules contributed tags whenever the planned tags change.
+			if v := defaultTagsConfig.RuleTagSources(resourceTags); v != "" {
+				var stateTagsAll tftags.Map
+				if !request.State.Raw.IsNull() {
+					response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+				}
+
+				if !tftags.New(ctx, stateTagsAll).Equal(allTags) {
+					response.Diagnostics.AddWarning("Default tags applied by default_tags rules", v)
+				}
+			}
 		} else {
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 		}
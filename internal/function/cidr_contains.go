@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrContainsFunction{}
+
+func NewCIDRContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+type cidrContainsFunction struct{}
+
+func (f cidrContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f cidrContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_contains Function",
+		MarkdownDescription: "Checks whether an IP address or CIDR block is contained within a CIDR block. " +
+			"An IPv4 address or CIDR block is never contained within an IPv6 CIDR block, and vice versa.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "containing_cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+			function.StringParameter{
+				Name:                "ip_address_or_cidr_block",
+				MarkdownDescription: "IP address or CIDR block to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var containingCIDRBlock, v string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &containingCIDRBlock, &v))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cidrContains(containingCIDRBlock, v)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrContains returns whether an IP address or CIDR block is wholly contained within a CIDR block
+func cidrContains(containingCIDRBlock, v string) (bool, error) {
+	container, err := parseCIDRBlock(containingCIDRBlock)
+	if err != nil {
+		return false, err
+	}
+
+	var contained netip.Prefix
+	if strings.Contains(v, "/") {
+		contained, err = parseCIDRBlock(v)
+		if err != nil {
+			return false, err
+		}
+	} else {
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return false, fmt.Errorf("%q is not a valid IP address: %w", v, err)
+		}
+		contained = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	if container.Addr().Is4() != contained.Addr().Is4() {
+		return false, nil
+	}
+
+	return contained.Bits() >= container.Bits() && container.Contains(contained.Addr()), nil
+}
+
+func parseCIDRBlock(cidrBlock string) (netip.Prefix, error) {
+	if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return netip.ParsePrefix(cidrBlock)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestCIDRContains(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		containingCIDRBlock string
+		v                   string
+		expected            bool
+		expectError         bool
+	}{
+		"IPv4 CIDR block contained": {
+			containingCIDRBlock: "10.0.0.0/16",
+			v:                   "10.0.1.0/24",
+			expected:            true,
+		},
+		"IPv4 CIDR block equal": {
+			containingCIDRBlock: "10.0.0.0/16",
+			v:                   "10.0.0.0/16",
+			expected:            true,
+		},
+		"IPv4 CIDR block not contained": {
+			containingCIDRBlock: "10.0.0.0/16",
+			v:                   "10.1.0.0/24",
+			expected:            false,
+		},
+		"IPv4 CIDR block larger": {
+			containingCIDRBlock: "10.0.0.0/16",
+			v:                   "10.0.0.0/8",
+			expected:            false,
+		},
+		"IPv4 address contained": {
+			containingCIDRBlock: "10.0.0.0/16",
+			v:                   "10.0.255.255",
+			expected:            true,
+		},
+		"IPv4 address not contained": {
+			containingCIDRBlock: "10.0.0.0/16",
+			v:                   "10.1.0.0",
+			expected:            false,
+		},
+		"IPv6 CIDR block contained": {
+			containingCIDRBlock: "2001:db8::/32",
+			v:                   "2001:db8:1::/48",
+			expected:            true,
+		},
+		"IPv6 address contained": {
+			containingCIDRBlock: "2001:db8::/32",
+			v:                   "2001:db8:1::1",
+			expected:            true,
+		},
+		"IPv6 address not contained": {
+			containingCIDRBlock: "2001:db8::/32",
+			v:                   "2001:db9::1",
+			expected:            false,
+		},
+		"mixed address families": {
+			containingCIDRBlock: "10.0.0.0/16",
+			v:                   "2001:db8::1",
+			expected:            false,
+		},
+		"invalid containing CIDR block": {
+			containingCIDRBlock: "10.0.0.1/16",
+			v:                   "10.0.0.1",
+			expectError:         true,
+		},
+		"invalid CIDR block": {
+			containingCIDRBlock: "10.0.0.0/16",
+			v:                   "10.0.0.0/33",
+			expectError:         true,
+		},
+		"invalid IP address": {
+			containingCIDRBlock: "10.0.0.0/16",
+			v:                   "10.0.0",
+			expectError:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.CIDRContains(testCase.containingCIDRBlock, testCase.v)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestCIDRContainsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRContainsFunctionConfig("10.0.0.0/16", "10.0.1.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testCIDRContainsFunctionConfig("10.0.0.0/16", "10.1.0.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRContainsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRContainsFunctionConfig("10.0.0.0/16", "foo"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*IP[\s\n]*address`),
+			},
+		},
+	})
+}
+
+func testCIDRContainsFunctionConfig(containingCIDRBlock, v string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cidr_contains(%[1]q, %[2]q)
+}`, containingCIDRBlock, v)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrSubnetsForAZsFunction{}
+
+func NewCIDRSubnetsForAZsFunction() function.Function {
+	return &cidrSubnetsForAZsFunction{}
+}
+
+type cidrSubnetsForAZsFunction struct{}
+
+func (f cidrSubnetsForAZsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_for_azs"
+}
+
+func (f cidrSubnetsForAZsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_for_azs Function",
+		MarkdownDescription: "Splits an IPv4 or IPv6 CIDR block into non-overlapping subnets, one per Availability Zone " +
+			"for each tier. Returns a map of tier name to a map of Availability Zone to subnet CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to split",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zones to allocate a subnet in for each tier",
+				ElementType:         types.StringType,
+			},
+			function.MapParameter{
+				Name:                "tiers",
+				MarkdownDescription: "Map of tier name to the number of additional prefix bits for the tier's subnets",
+				ElementType:         types.Int64Type,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.MapType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
+func (f cidrSubnetsForAZsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var azs []string
+	var tiers map[string]int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &azs, &tiers))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cidrSubnetsForAZs(cidrBlock, azs, tiers)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrSubnetsForAZs allocates one subnet per Availability Zone for each tier from a CIDR block.
+// Tiers are allocated largest subnets first, then by tier name, and Availability Zones in the
+// order specified. Each subnet is aligned to its size so that subnets are packed without gaps
+func cidrSubnetsForAZs(cidrBlock string, azs []string, tiers map[string]int64) (map[string]map[string]string, error) {
+	if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return nil, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(azs) == 0 {
+		return nil, fmt.Errorf("at least one Availability Zone must be specified")
+	}
+	for i, az := range azs {
+		if az == "" {
+			return nil, fmt.Errorf("Availability Zone %d must not be empty", i)
+		}
+		if slices.Contains(azs[:i], az) {
+			return nil, fmt.Errorf("duplicate Availability Zone: %s", az)
+		}
+	}
+
+	if len(tiers) == 0 {
+		return nil, fmt.Errorf("at least one tier must be specified")
+	}
+
+	addressBits := prefix.Addr().BitLen()
+	for name, newbits := range tiers {
+		if newbits < 0 || int64(prefix.Bits())+newbits > int64(addressBits) {
+			return nil, fmt.Errorf("tier %q: %d additional prefix bits would extend prefix /%d beyond %d bits", name, newbits, prefix.Bits(), addressBits)
+		}
+	}
+
+	names := slices.SortedFunc(maps.Keys(tiers), func(a, b string) int {
+		return cmp.Or(cmp.Compare(tiers[a], tiers[b]), cmp.Compare(a, b))
+	})
+
+	base := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	end := new(big.Int).Add(base, new(big.Int).Lsh(big.NewInt(1), uint(addressBits-prefix.Bits())))
+	offset := new(big.Int).Set(base)
+	result := make(map[string]map[string]string, len(tiers))
+
+	for _, name := range names {
+		subnetBits := prefix.Bits() + int(tiers[name])
+		size := new(big.Int).Lsh(big.NewInt(1), uint(addressBits-subnetBits))
+
+		// Align the next subnet to its size.
+		if rem := new(big.Int).Mod(new(big.Int).Sub(offset, base), size); rem.Sign() != 0 {
+			offset.Add(offset, new(big.Int).Sub(size, rem))
+		}
+
+		result[name] = make(map[string]string, len(azs))
+
+		for _, az := range azs {
+			if new(big.Int).Add(offset, size).Cmp(end) > 0 {
+				return nil, fmt.Errorf("tier %q: insufficient address space in %s for a /%d subnet in %s", name, cidrBlock, subnetBits, az)
+			}
+
+			addr, ok := netip.AddrFromSlice(offset.FillBytes(make([]byte, addressBits/8)))
+			if !ok {
+				return nil, fmt.Errorf("tier %q: invalid subnet address", name)
+			}
+
+			result[name][az] = netip.PrefixFrom(addr, subnetBits).String()
+			offset.Add(offset, size)
+		}
+	}
+
+	return result, nil
+}
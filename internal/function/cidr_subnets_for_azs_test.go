@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestCIDRSubnetsForAZs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlock     string
+		azs           []string
+		tiers         map[string]int64
+		expected      map[string]map[string]string
+		expectedError *regexp.Regexp
+	}{
+		"IPv4": {
+			cidrBlock: "10.0.0.0/16",
+			azs:       []string{"us-west-2a", "us-west-2b", "us-west-2c"}, //lintignore:AWSAT003
+			tiers: map[string]int64{
+				"private": 4,
+				"public":  8,
+			},
+			expected: map[string]map[string]string{
+				"private": {
+					"us-west-2a": "10.0.0.0/20",  //lintignore:AWSAT003
+					"us-west-2b": "10.0.16.0/20", //lintignore:AWSAT003
+					"us-west-2c": "10.0.32.0/20", //lintignore:AWSAT003
+				},
+				"public": {
+					"us-west-2a": "10.0.48.0/24", //lintignore:AWSAT003
+					"us-west-2b": "10.0.49.0/24", //lintignore:AWSAT003
+					"us-west-2c": "10.0.50.0/24", //lintignore:AWSAT003
+				},
+			},
+		},
+		"IPv4 alignment": {
+			cidrBlock: "10.0.0.0/24",
+			azs:       []string{"a", "b", "c"},
+			tiers: map[string]int64{
+				"large": 2,
+				"small": 4,
+			},
+			expected: map[string]map[string]string{
+				"large": {
+					"a": "10.0.0.0/26",
+					"b": "10.0.0.64/26",
+					"c": "10.0.0.128/26",
+				},
+				"small": {
+					"a": "10.0.0.192/28",
+					"b": "10.0.0.208/28",
+					"c": "10.0.0.224/28",
+				},
+			},
+		},
+		"IPv4 same size tiers by name": {
+			cidrBlock: "192.168.0.0/22",
+			azs:       []string{"a", "b"},
+			tiers: map[string]int64{
+				"db":  2,
+				"app": 2,
+			},
+			expected: map[string]map[string]string{
+				"app": {
+					"a": "192.168.0.0/24",
+					"b": "192.168.1.0/24",
+				},
+				"db": {
+					"a": "192.168.2.0/24",
+					"b": "192.168.3.0/24",
+				},
+			},
+		},
+		"IPv4 zero newbits": {
+			cidrBlock: "10.0.0.0/24",
+			azs:       []string{"a"},
+			tiers: map[string]int64{
+				"all": 0,
+			},
+			expected: map[string]map[string]string{
+				"all": {
+					"a": "10.0.0.0/24",
+				},
+			},
+		},
+		"IPv6": {
+			cidrBlock: "2600:1f14:abcd:ef00::/56",
+			azs:       []string{"a", "b"},
+			tiers: map[string]int64{
+				"private": 8,
+				"public":  8,
+			},
+			expected: map[string]map[string]string{
+				"private": {
+					"a": "2600:1f14:abcd:ef00::/64",
+					"b": "2600:1f14:abcd:ef01::/64",
+				},
+				"public": {
+					"a": "2600:1f14:abcd:ef02::/64",
+					"b": "2600:1f14:abcd:ef03::/64",
+				},
+			},
+		},
+		"invalid CIDR block": {
+			cidrBlock:     "10.0.0.0",
+			azs:           []string{"a"},
+			tiers:         map[string]int64{"x": 1},
+			expectedError: regexache.MustCompile(`is not a valid CIDR block`),
+		},
+		"CIDR block not network address": {
+			cidrBlock:     "10.0.0.1/16",
+			azs:           []string{"a"},
+			tiers:         map[string]int64{"x": 1},
+			expectedError: regexache.MustCompile(`did you mean "10.0.0.0/16"`),
+		},
+		"no Availability Zones": {
+			cidrBlock:     "10.0.0.0/16",
+			tiers:         map[string]int64{"x": 1},
+			expectedError: regexache.MustCompile(`at least one Availability Zone`),
+		},
+		"duplicate Availability Zones": {
+			cidrBlock:     "10.0.0.0/16",
+			azs:           []string{"a", "b", "a"},
+			tiers:         map[string]int64{"x": 4},
+			expectedError: regexache.MustCompile(`duplicate Availability Zone: a`),
+		},
+		"no tiers": {
+			cidrBlock:     "10.0.0.0/16",
+			azs:           []string{"a"},
+			expectedError: regexache.MustCompile(`at least one tier`),
+		},
+		"negative newbits": {
+			cidrBlock:     "10.0.0.0/16",
+			azs:           []string{"a"},
+			tiers:         map[string]int64{"x": -1},
+			expectedError: regexache.MustCompile(`additional prefix bits`),
+		},
+		"too many newbits": {
+			cidrBlock:     "10.0.0.0/24",
+			azs:           []string{"a"},
+			tiers:         map[string]int64{"x": 9},
+			expectedError: regexache.MustCompile(`beyond 32 bits`),
+		},
+		"insufficient address space": {
+			cidrBlock:     "10.0.0.0/24",
+			azs:           []string{"a", "b", "c"},
+			tiers:         map[string]int64{"x": 1},
+			expectedError: regexache.MustCompile(`insufficient address space`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.CIDRSubnetsForAZs(testCase.cidrBlock, testCase.azs, testCase.tiers)
+
+			if testCase.expectedError != nil {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedError)
+				}
+				if !testCase.expectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got %q", testCase.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCIDRSubnetsForAZsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("private_a", "10.0.0.0/20"),
+					resource.TestCheckOutput("private_b", "10.0.16.0/20"),
+					resource.TestCheckOutput("public_a", "10.0.32.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.33.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/28"),
+				ExpectError: regexache.MustCompile(`beyond[\s\n]*32[\s\n]*bits`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsForAZsFunctionConfig(cidrBlock string) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_for_azs(%[1]q, ["a", "b"], {
+    private = 4
+    public  = 8
+  })
+}
+
+output "private_a" {
+  value = local.subnets["private"]["a"]
+}
+
+output "private_b" {
+  value = local.subnets["private"]["b"]
+}
+
+output "public_a" {
+  value = local.subnets["public"]["a"]
+}
+
+output "public_b" {
+  value = local.subnets["public"]["b"]
+}
+`, cidrBlock)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+)
+
+var _ function.Function = ecsContainerDefinitionsNormalizeFunction{}
+
+func NewECSContainerDefinitionsNormalizeFunction() function.Function {
+	return &ecsContainerDefinitionsNormalizeFunction{}
+}
+
+type ecsContainerDefinitionsNormalizeFunction struct{}
+
+func (f ecsContainerDefinitionsNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecs_container_definitions_normalize"
+}
+
+func (f ecsContainerDefinitionsNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ecs_container_definitions_normalize Function",
+		MarkdownDescription: "Normalizes ECS container definitions to minified JSON, " +
+			"as compared by the provider to detect changes in a task definition's container definitions.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "container_definitions",
+				MarkdownDescription: "ECS container definitions (JSON)",
+			},
+			function.StringParameter{
+				Name:                "network_mode",
+				MarkdownDescription: "Task definition network mode",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ecsContainerDefinitionsNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var containerDefinitions string
+	var networkMode types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &containerDefinitions, &networkMode))
+	if resp.Error != nil {
+		return
+	}
+
+	isAWSVPC := networkMode.ValueString() == string(awstypes.NetworkModeAwsvpc)
+	result, err := tfecs.NormalizeContainerDefinitions(containerDefinitions, isAWSVPC)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECSContainerDefinitionsNormalizeFunction_basic(t *testing.T) {
+	t.Parallel()
+	arg := `[
+  {
+    "name": "web",
+    "image": "nginx",
+    "environment": [
+      {"name": "B", "value": "2"},
+      {"name": "A", "value": "1"}
+    ],
+    "portMappings": [
+      {"containerPort": 80, "protocol": "tcp"}
+    ],
+    "mountPoints": []
+  }
+]`
+	expected := `[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx","name":"web","portMappings":[{"containerPort":80}]}]`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECSContainerDefinitionsNormalizeFunctionConfig(arg, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestECSContainerDefinitionsNormalizeFunction_awsvpc(t *testing.T) {
+	t.Parallel()
+	arg := `[{"name": "web", "image": "nginx", "portMappings": [{"containerPort": 80}]}]`
+	expected := `[{"essential":true,"image":"nginx","name":"web","portMappings":[{"containerPort":80,"hostPort":80}]}]`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECSContainerDefinitionsNormalizeFunctionConfig(arg, `"awsvpc"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestECSContainerDefinitionsNormalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+	arg := `[{}]`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECSContainerDefinitionsNormalizeFunctionConfig(arg, "null"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*container[\s\n]*definition`),
+			},
+		},
+	})
+}
+
+func testECSContainerDefinitionsNormalizeFunctionConfig(arg, networkMode string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::ecs_container_definitions_normalize(%[1]q, %[2]s)
+}`, arg, networkMode)
+}
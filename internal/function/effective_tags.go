@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var effectiveTagsIgnoreTagsAttrTypes = map[string]attr.Type{
+	"keys":         types.ListType{ElemType: types.StringType},
+	"key_prefixes": types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = effectiveTagsFunction{}
+
+func NewEffectiveTagsFunction() function.Function {
+	return &effectiveTagsFunction{}
+}
+
+type effectiveTagsFunction struct{}
+
+type effectiveTagsIgnoreTags struct {
+	Keys        []string `tfsdk:"keys"`
+	KeyPrefixes []string `tfsdk:"key_prefixes"`
+}
+
+func (f effectiveTagsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "effective_tags"
+}
+
+func (f effectiveTagsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "effective_tags Function",
+		MarkdownDescription: "Computes the tags the provider will apply to a resource from the resource's tags, " +
+			"the provider's default tags and the provider's ignored tag keys and key prefixes. " +
+			"Default tags rules and ignored tag patterns are not supported, so the result can differ from the resource's `tags_all`. " +
+			"Returns an error for resource tags that duplicate a default tag, or override a default tag with an empty value.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "Resource tags",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+			function.MapParameter{
+				Name:                "default_tags",
+				MarkdownDescription: "Tags configured in the provider's `default_tags` block",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+			function.ObjectParameter{
+				Name:                "ignore_tags",
+				MarkdownDescription: "Keys and key prefixes configured in the provider's `ignore_tags` block",
+				AttributeTypes:      effectiveTagsIgnoreTagsAttrTypes,
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f effectiveTagsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceTags, defaultTags map[string]types.String
+	var ignoreTags *effectiveTagsIgnoreTags
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourceTags, &defaultTags, &ignoreTags))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := effectiveTags(ctx, resourceTags, defaultTags, ignoreTags)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// effectiveTags merges resource tags over default tags and removes ignored and AWS system tags.
+// Only the provider-level default tags and ignored tag keys and key prefixes are applied, so the result
+// does not reflect default_tags rules, ignore_tags patterns or regular expressions, or service-specific system tags
+func effectiveTags(ctx context.Context, resourceTags, defaultTags map[string]types.String, ignoreTags *effectiveTagsIgnoreTags) (map[string]string, error) {
+	var errs []string
+
+	resource, err := stringMapValue("tags", resourceTags)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	defaults, err := stringMapValue("default_tags", defaultTags)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(resource)) {
+		v := resource[k]
+		defaultValue, ok := defaults[k]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case v == defaultValue:
+			errs = append(errs, fmt.Sprintf("tag %q duplicates the default tag with the same value; remove it from tags", k))
+		case v == "":
+			errs = append(errs, fmt.Sprintf("tag %q overrides the default tag value %q with an empty value", k, defaultValue))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	defaultConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, defaults),
+	}
+
+	ignoreConfig := &tftags.IgnoreConfig{}
+	if ignoreTags != nil {
+		if len(ignoreTags.Keys) > 0 {
+			ignoreConfig.Keys = tftags.New(ctx, ignoreTags.Keys)
+		}
+		if len(ignoreTags.KeyPrefixes) > 0 {
+			ignoreConfig.KeyPrefixes = tftags.New(ctx, ignoreTags.KeyPrefixes)
+		}
+	}
+
+	return defaultConfig.MergeTags(tftags.New(ctx, resource)).IgnoreAWS().IgnoreConfig(ignoreConfig).Map(), nil
+}
+
+// stringMapValue returns the Go value of a map of strings, failing on null elements
+func stringMapValue(name string, m map[string]types.String) (map[string]string, error) {
+	result := make(map[string]string, len(m))
+
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		v := m[k]
+		if v.IsNull() {
+			return nil, fmt.Errorf("%s: tag %q has a null value", name, k)
+		}
+
+		result[k] = v.ValueString()
+	}
+
+	return result, nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestEffectiveTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tags          map[string]types.String
+		defaultTags   map[string]types.String
+		ignoreTags    *tffunction.EffectiveTagsIgnoreTags
+		expected      map[string]string
+		expectedError string
+	}{
+		"all null": {
+			expected: map[string]string{},
+		},
+		"tags only": {
+			tags: map[string]types.String{
+				"Name": types.StringValue("example"),
+			},
+			expected: map[string]string{
+				"Name": "example",
+			},
+		},
+		"default tags only": {
+			defaultTags: map[string]types.String{
+				"Environment": types.StringValue("test"),
+			},
+			expected: map[string]string{
+				"Environment": "test",
+			},
+		},
+		"tags override default tags": {
+			tags: map[string]types.String{
+				"Environment": types.StringValue("prod"),
+				"Name":        types.StringValue("example"),
+			},
+			defaultTags: map[string]types.String{
+				"Environment": types.StringValue("test"),
+				"Owner":       types.StringValue("team"),
+			},
+			expected: map[string]string{
+				"Environment": "prod",
+				"Name":        "example",
+				"Owner":       "team",
+			},
+		},
+		"empty values": {
+			tags: map[string]types.String{
+				"Empty": types.StringValue(""),
+			},
+			defaultTags: map[string]types.String{
+				"DefaultEmpty": types.StringValue(""),
+			},
+			expected: map[string]string{
+				"DefaultEmpty": "",
+				"Empty":        "",
+			},
+		},
+		"ignore tags": {
+			tags: map[string]types.String{
+				"Name":            types.StringValue("example"),
+				"kubernetes.io/a": types.StringValue("owned"),
+				"LastScanned":     types.StringValue("yesterday"),
+			},
+			defaultTags: map[string]types.String{
+				"kubernetes.io/b": types.StringValue("shared"),
+			},
+			ignoreTags: &tffunction.EffectiveTagsIgnoreTags{
+				Keys:        []string{"LastScanned"},
+				KeyPrefixes: []string{"kubernetes.io/"},
+			},
+			expected: map[string]string{
+				"Name": "example",
+			},
+		},
+		"AWS system tags": {
+			tags: map[string]types.String{
+				"Name":                          types.StringValue("example"),
+				"aws:cloudformation:stack-name": types.StringValue("stack"),
+			},
+			expected: map[string]string{
+				"Name": "example",
+			},
+		},
+		"duplicate": {
+			tags: map[string]types.String{
+				"Environment": types.StringValue("test"),
+			},
+			defaultTags: map[string]types.String{
+				"Environment": types.StringValue("test"),
+			},
+			expectedError: `tag "Environment" duplicates the default tag`,
+		},
+		"empty value overrides default tag": {
+			tags: map[string]types.String{
+				"Environment": types.StringValue(""),
+			},
+			defaultTags: map[string]types.String{
+				"Environment": types.StringValue("test"),
+			},
+			expectedError: `tag "Environment" overrides the default tag value "test" with an empty value`,
+		},
+		"null value": {
+			tags: map[string]types.String{
+				"Environment": types.StringNull(),
+			},
+			expectedError: `tags: tag "Environment" has a null value`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.EffectiveTags(context.Background(), testCase.tags, testCase.defaultTags, testCase.ignoreTags)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEffectiveTagsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  tags = provider::aws::effective_tags(
+    { Name = "example", Environment = "prod", LastScanned = "yesterday" },
+    { Environment = "test", Owner = "team" },
+    { keys = ["LastScanned"], key_prefixes = [] },
+  )
+}
+
+output "test" {
+  value = join(",", [for k in sort(keys(local.tags)) : "${k}=${local.tags[k]}"])
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Environment=prod,Name=example,Owner=team"),
+				),
+			},
+		},
+	})
+}
+
+func TestEffectiveTagsFunction_duplicate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::effective_tags({ Environment = "test" }, { Environment = "test" }, null)
+}
+`,
+				ExpectError: regexache.MustCompile(`duplicates[\s\n]*the[\s\n]*default[\s\n]*tag`),
+			},
+		},
+	})
+}
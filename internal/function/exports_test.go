@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+// Exports for use in tests only.
+var (
+	CIDRContains      = cidrContains
+	CIDRSubnetsForAZs = cidrSubnetsForAZs
+	EffectiveTags     = effectiveTags
+)
+
+type (
+	EffectiveTagsIgnoreTags = effectiveTagsIgnoreTags
+)
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyEquivalentFunction_equivalent(t *testing.T) {
+	t.Parallel()
+	policy1 := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`
+	policy2 := `{"Statement":{"Resource":["*"],"Action":"s3:GetObject","Effect":"Allow"},"Version":"2012-10-17"}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEquivalentFunctionConfig(policy1, policy2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEquivalentFunction_notEquivalent(t *testing.T) {
+	t.Parallel()
+	policy1 := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	policy2 := `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEquivalentFunctionConfig(policy1, policy2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEquivalentFunction_invalid(t *testing.T) {
+	t.Parallel()
+	policy1 := `{"Version":"2012-10-17","Statement":[]}`
+	policy2 := `{`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEquivalentFunctionConfig(policy1, policy2),
+				ExpectError: regexache.MustCompile(`policy2[\s\n]*is[\s\n]*not[\s\n]*valid[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEquivalentFunctionConfig(policy1, policy2 string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_equivalent(%[1]q, %[2]q)
+}`, policy1, policy2)
+}
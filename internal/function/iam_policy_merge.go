@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges a list of IAM policy documents into a single policy document. " +
+			"Statements with the same non-empty Sid in later documents replace those in earlier documents, " +
+			"in the same way as the `override_policy_documents` argument of the `aws_iam_policy_document` data source.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				MarkdownDescription: "IAM policy documents (JSON) to merge, in order",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	policies := make([]string, 0, len(args))
+	for _, v := range args {
+		if v.IsNull() {
+			continue
+		}
+		policies = append(policies, v.ValueString())
+	}
+
+	result, err := verify.MergePolicies(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
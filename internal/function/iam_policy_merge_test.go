@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Deny","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_singleStatement(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig_singleStatement(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig_invalid(),
+				ExpectError: regexache.MustCompile(`policy[\s\n]*document[\s\n]*1`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig_basic() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge([
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "Read"
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      }]
+    }),
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "Read"
+        Effect   = "Deny"
+        Action   = "s3:GetObject"
+        Resource = "*"
+        }, {
+        Effect   = "Allow"
+        Action   = "s3:ListBucket"
+        Resource = "*"
+      }]
+    }),
+  ])
+}`
+}
+
+func testIAMPolicyMergeFunctionConfig_singleStatement() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge([
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = {
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      }
+    }),
+    jsonencode({
+      Statement = {
+        Effect   = "Allow"
+        Action   = "s3:ListBucket"
+        Resource = "*"
+      }
+    }),
+  ])
+}`
+}
+
+func testIAMPolicyMergeFunctionConfig_invalid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge([
+    jsonencode({
+      Version = "2012-10-17"
+    }),
+    "{",
+  ])
+}`
+}
@@ -24,7 +24,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		newPlanWarningsProviderServer(newMoveStateProviderServer(primary, sdkStateMovers(ctx, servicePackages(ctx)))),
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -835,3 +838,117 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// deletionGuardResourceInterceptor prevents deletion of resources tagged as protected by the provider's deletion_guard configuration.
+type deletionGuardResourceInterceptor struct{}
+
+func (r deletionGuardResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionGuardResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionGuardResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionGuardResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	deletionGuardConfig := meta.DeletionGuardConfig(ctx)
+	if deletionGuardConfig == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		var stateTagsAll tftags.Map
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if !deletionGuardConfig.Protects(tftags.New(ctx, stateTagsAll)) {
+			return ctx, diags
+		}
+
+		serviceName, resourceName := "<service>", "<thing>"
+		if inContext, ok := conns.FromContext(ctx); ok {
+			if v, err := names.HumanFriendly(inContext.ServicePackageName); err == nil {
+				serviceName = v
+			}
+			if v := inContext.ResourceName; v != "" {
+				resourceName = v
+			}
+		}
+
+		// Not all resources have an `id` attribute.
+		var identifier string
+		request.State.GetAttribute(ctx, path.Root(names.AttrID), &identifier)
+
+		diags.AddError(fmt.Sprintf("deleting %s %s (%s): protected by deletion guard", serviceName, resourceName, identifier), deletionGuardConfig.ErrorDetail())
+	}
+
+	return ctx, diags
+}
+
+// authorizationMessageInterceptor decodes any encoded authorization failure message in error diagnostics.
+// Decoding requires the sts:DecodeAuthorizationMessage permission.
+type authorizationMessageInterceptor struct{}
+
+func (r authorizationMessageInterceptor) run(ctx context.Context, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case OnError:
+		diags = fwdiag.DecodeAuthorizationMessages(ctx, diags, func(ctx context.Context, encodedMessage string) (string, error) {
+			return decodeAuthorizationMessage(ctx, meta.STSClient(ctx), encodedMessage)
+		})
+	}
+
+	return ctx, diags
+}
+
+func (r authorizationMessageInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r authorizationMessageInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r authorizationMessageInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r authorizationMessageInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+// authorizationMessageDataSourceInterceptor decodes any encoded authorization failure message in data source error diagnostics.
+type authorizationMessageDataSourceInterceptor struct{}
+
+func (r authorizationMessageDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return authorizationMessageInterceptor{}.run(ctx, meta, when, diags)
+}
+
+func decodeAuthorizationMessage(ctx context.Context, conn *sts.Client, encodedMessage string) (string, error) {
+	input := &sts.DecodeAuthorizationMessageInput{
+		EncodedMessage: aws.String(encodedMessage),
+	}
+
+	output, err := conn.DecodeAuthorizationMessage(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.DecodedMessage), nil
+}
@@ -136,6 +136,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Skip the credentials validation via STS API. Used for AWS API implementations that do not have STS available/implemented.",
 			},
+			"skip_data_source_caching": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip caching the results of idempotent data sources, such as aws_caller_identity, for the duration of a Terraform operation.",
+			},
 			"skip_metadata_api_check": schema.StringAttribute{
 				Optional:    true,
 				Description: "Skip the AWS Metadata API check. Used for AWS API implementations that do not have a metadata api endpoint.",
@@ -267,6 +271,58 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						names.AttrRule: schema.ListNestedBlock{
+							Description: "Configuration block with resource tags to default across the resource types matching the rule. Later rules take precedence.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource type name patterns, e.g. `aws_s3_bucket_*`, to which the rule does not apply.",
+									},
+									"include_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource type name patterns, e.g. `aws_s3_*`, to which the rule applies. If not set, the rule applies to all resource types.",
+									},
+									names.AttrName: schema.StringAttribute{
+										Optional:    true,
+										Description: "Name of the rule, used to identify the rule in logs.",
+									},
+									"services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Services, e.g. `ec2`, to which the rule applies. If not set, the rule applies to all services.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across the resource types matching the rule.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"deletion_guard": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to prevent deletion of tagged resources across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_key": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource tag key identifying resources that cannot be deleted.",
+						},
+						"tag_value": schema.StringAttribute{
+							Optional: true,
+							Description: "Resource tag value identifying resources that cannot be deleted. If not set, any value matches. " +
+								"The deletion guard can be disabled with the " + tftags.DeletionGuardDisabledEnvVar + " environment variable.",
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -283,6 +339,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Glob patterns, e.g. `kubernetes.io/cluster/*`, matching resource tag keys to ignore across all resources.",
+						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -290,6 +356,50 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"tag_pattern": schema.ListNestedBlock{
+							Description: "Configuration block with glob patterns matching the key and value of resource tags to ignore across all resources.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Glob pattern matching the resource tag key.",
+									},
+									names.AttrValue: schema.StringAttribute{
+										Required:    true,
+										Description: "Glob pattern matching the resource tag value.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with AWS API request limits for individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_concurrent_requests": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of in-flight requests to the service's API.",
+						},
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times a request to the service's API is attempted. Overrides the provider's `max_retries`.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum rate of requests to the service's API.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how requests to the service's API are retried. Valid values are `standard` and `adaptive`. Overrides the provider's `retry_mode`.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `route53`. Any service name accepted in the `endpoints` block can be used.",
+						},
+					},
 				},
 			},
 		},
@@ -346,14 +456,19 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 				return ctx
 			}
-			interceptors := dataSourceInterceptors{}
+			interceptors := dataSourceInterceptors{authorizationMessageDataSourceInterceptor{}}
+
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+			if isRegionOverrideEnabled(servicePackageName, schemaResponse.Schema.Attributes) {
+				inner = newRegionDataSource(inner)
+				interceptors = append(interceptors, regionDataSourceInterceptor{})
+			}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -410,21 +525,26 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx).ForResourceType(servicePackageName, typeName), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
 					ctx = flex.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{authorizationMessageInterceptor{}}
+
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			if isRegionOverrideEnabled(servicePackageName, schemaResponse.Schema.Attributes) {
+				inner = newRegionResource(inner)
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -447,6 +567,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if _, ok := schemaResponse.Schema.Attributes[names.AttrTagsAll]; ok {
+				interceptors = append(interceptors, deletionGuardResourceInterceptor{})
+			}
+
 			resources = append(resources, func() resource.Resource {
 				return newWrappedResource(bootstrapContext, inner, interceptors)
 			})
@@ -523,6 +647,13 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRContainsFunction,
+		tffunction.NewCIDRSubnetsForAZsFunction,
+		tffunction.NewECSContainerDefinitionsNormalizeFunction,
+		tffunction.NewEffectiveTagsFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -0,0 +1,758 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionAttributeDescription = "Region where this resource will be managed. Defaults to the Region set in the provider configuration."
+)
+
+// isRegionOverrideEnabled returns whether the top-level `region` attribute can be added to a resource or data source.
+// Resources and data sources in global service packages or whose schema already defines `region` are not eligible.
+func isRegionOverrideEnabled[T any](servicePackageName string, attributes map[string]T) bool {
+	if names.IsGlobalService(servicePackageName) {
+		return false
+	}
+
+	_, ok := attributes[names.AttrRegion]
+
+	return !ok
+}
+
+type attributeGetter interface {
+	GetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}
+
+// setOverrideRegionInContext sets any configured value of the top-level `region` attribute as the per-resource Region override.
+func setOverrideRegionInContext(ctx context.Context, getter attributeGetter, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	var region types.String
+	diags.Append(getter.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := region.ValueString(); v != "" {
+		inContext.OverrideRegion = v
+	}
+
+	if err := meta.ValidateInContextRegionInPartition(ctx); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+	}
+
+	return diags
+}
+
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(setOverrideRegionInContext(ctx, request.Config, meta)...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(setOverrideRegionInContext(ctx, request.Plan, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		// State written before the `region` attribute was introduced has no value.
+		// In that case the configured AWS Region is used.
+		diags.Append(setOverrideRegionInContext(ctx, request.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(setOverrideRegionInContext(ctx, request.Plan, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(setOverrideRegionInContext(ctx, request.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+// withoutRegion returns the specified object value, which includes the top-level `region` attribute, as a value of the specified type.
+func withoutRegion(v tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var vals map[string]tftypes.Value
+	if err := v.As(&vals); err != nil {
+		return tftypes.Value{}, err
+	}
+	// Don't modify the specified value's attributes.
+	vals = maps.Clone(vals)
+	delete(vals, names.AttrRegion)
+
+	return tftypes.NewValue(typ, vals), nil
+}
+
+// withRegion returns the specified object value, which excludes the top-level `region` attribute, as a value of the specified type.
+// The `region` attribute is set to the specified value.
+func withRegion(v tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var vals map[string]tftypes.Value
+	if err := v.As(&vals); err != nil {
+		return tftypes.Value{}, err
+	}
+	// Don't modify the specified value's attributes.
+	vals = maps.Clone(vals)
+	vals[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, vals), nil
+}
+
+// regionValue returns the top-level `region` attribute value from the specified object value.
+func regionValue(v tftypes.Value) tftypes.Value {
+	if v.IsNull() || !v.IsKnown() {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	var vals map[string]tftypes.Value
+	if err := v.As(&vals); err != nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	if v, ok := vals[names.AttrRegion]; ok {
+		return v
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// rawStateRegionValue returns the top-level `region` attribute value from the specified raw state.
+// A null value is returned if the raw state has no `region` attribute or is not JSON-encoded.
+func rawStateRegionValue(rawState *tfprotov6.RawState) tftypes.Value {
+	if rawState == nil || len(rawState.JSON) == 0 {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	var v struct {
+		Region *string `json:"region"`
+	}
+	if err := json.Unmarshal(rawState.JSON, &v); err != nil || v.Region == nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(tftypes.String, *v.Region)
+}
+
+func addDiagnosticFromError(diags *diag.Diagnostics, err error) bool {
+	if err != nil {
+		diags.AddError("Per-resource Region override", err.Error())
+		return true
+	}
+
+	return false
+}
+
+// regionDataSource adds the top-level `region` attribute to a data source's schema.
+// Values passed to and from the inner data source conform to the inner data source's schema.
+type regionDataSource struct {
+	inner datasource.DataSourceWithConfigure
+	meta  *conns.AWSClient
+}
+
+func newRegionDataSource(inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
+	return &regionDataSource{
+		inner: inner,
+	}
+}
+
+func (d *regionDataSource) schemas(ctx context.Context) (dsschema.Schema, dsschema.Schema) {
+	var response datasource.SchemaResponse
+	d.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+
+	inner := response.Schema
+	outer := inner
+	outer.Attributes = maps.Clone(inner.Attributes)
+	outer.Attributes[names.AttrRegion] = dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+
+	return inner, outer
+}
+
+func (d *regionDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	d.inner.Metadata(ctx, request, response)
+}
+
+func (d *regionDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	_, response.Schema = d.schemas(ctx)
+}
+
+func (d *regionDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		d.meta = v
+	}
+	d.inner.Configure(ctx, request, response)
+}
+
+func (d *regionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	inner, outer := d.schemas(ctx)
+	innerType, outerType := inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+
+	config, err := withoutRegion(request.Config.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	state, err := withoutRegion(response.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Raw: config, Schema: inner}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Raw: state, Schema: inner}
+
+	d.inner.Read(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Raw: tftypes.NewValue(outerType, nil), Schema: outer}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, err = withRegion(innerResponse.State.Raw, outerType, tftypes.NewValue(tftypes.String, d.meta.Region(ctx)))
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+func (d *regionDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	if v, ok := d.inner.(datasource.DataSourceWithConfigValidators); ok {
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+// regionResource adds the top-level `region` attribute to a resource's schema.
+// Values passed to and from the inner resource conform to the inner resource's schema.
+type regionResource struct {
+	inner resource.ResourceWithConfigure
+	meta  *conns.AWSClient
+}
+
+func newRegionResource(inner resource.ResourceWithConfigure) resource.ResourceWithConfigure {
+	return &regionResource{
+		inner: inner,
+	}
+}
+
+func (r *regionResource) schemas(ctx context.Context) (schema.Schema, schema.Schema) {
+	var response resource.SchemaResponse
+	r.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	inner := response.Schema
+	outer := inner
+	outer.Attributes = maps.Clone(inner.Attributes)
+	outer.Attributes[names.AttrRegion] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+
+	return inner, outer
+}
+
+func (r *regionResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	r.inner.Metadata(ctx, request, response)
+}
+
+func (r *regionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	_, response.Schema = r.schemas(ctx)
+}
+
+func (r *regionResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+	r.inner.Configure(ctx, request, response)
+}
+
+func (r *regionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	inner, outer := r.schemas(ctx)
+	innerType, outerType := inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+
+	config, err := withoutRegion(request.Config.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	plan, err := withoutRegion(request.Plan.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	state, err := withoutRegion(response.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Raw: config, Schema: inner}
+	innerRequest.Plan = tfsdk.Plan{Raw: plan, Schema: inner}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Raw: state, Schema: inner}
+
+	r.inner.Create(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Raw: tftypes.NewValue(outerType, nil), Schema: outer}
+
+	// A partially created resource may be saved to state even on error.
+	state, err = withRegion(innerResponse.State.Raw, outerType, tftypes.NewValue(tftypes.String, r.meta.Region(ctx)))
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+func (r *regionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	inner, outer := r.schemas(ctx)
+	innerType, outerType := inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+
+	priorState, err := withoutRegion(request.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	state, err := withoutRegion(response.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+
+	innerRequest := request
+	innerRequest.State = tfsdk.State{Raw: priorState, Schema: inner}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Raw: state, Schema: inner}
+
+	r.inner.Read(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State = request.State
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Existing state is upgraded to carry the Region on the first refresh.
+	state, err = withRegion(innerResponse.State.Raw, outerType, tftypes.NewValue(tftypes.String, r.meta.Region(ctx)))
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+func (r *regionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	inner, outer := r.schemas(ctx)
+	innerType, outerType := inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+
+	config, err := withoutRegion(request.Config.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	plan, err := withoutRegion(request.Plan.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	priorState, err := withoutRegion(request.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	state, err := withoutRegion(response.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Raw: config, Schema: inner}
+	innerRequest.Plan = tfsdk.Plan{Raw: plan, Schema: inner}
+	innerRequest.State = tfsdk.State{Raw: priorState, Schema: inner}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Raw: state, Schema: inner}
+
+	r.inner.Update(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Raw: tftypes.NewValue(outerType, nil), Schema: outer}
+
+	state, err = withRegion(innerResponse.State.Raw, outerType, tftypes.NewValue(tftypes.String, r.meta.Region(ctx)))
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+func (r *regionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	inner, outer := r.schemas(ctx)
+	innerType, outerType := inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+
+	priorState, err := withoutRegion(request.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	state, err := withoutRegion(response.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+
+	innerRequest := request
+	innerRequest.State = tfsdk.State{Raw: priorState, Schema: inner}
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Raw: state, Schema: inner}
+
+	r.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Raw: tftypes.NewValue(outerType, nil), Schema: outer}
+
+	state, err = withRegion(innerResponse.State.Raw, outerType, regionValue(request.State.Raw))
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+// ImportState supports an optional `@<region>` suffix on the import ID.
+// The suffix is removed from the ID and used as the per-resource Region override.
+func (r *regionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	v, ok := r.inner.(resource.ResourceWithImportState)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+
+		return
+	}
+
+	inner, outer := r.schemas(ctx)
+	innerType, outerType := inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+
+	region := tftypes.NewValue(tftypes.String, nil)
+	if id, v, ok := cutRegionSuffix(request.ID); ok {
+		request.ID = id
+		region = tftypes.NewValue(tftypes.String, v)
+	}
+
+	state, err := withoutRegion(response.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+
+	innerResponse := *response
+	innerResponse.State = tfsdk.State{Raw: state, Schema: inner}
+
+	v.ImportState(ctx, request, &innerResponse)
+
+	*response = innerResponse
+	response.State = tfsdk.State{Raw: tftypes.NewValue(outerType, nil), Schema: outer}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state, err = withRegion(innerResponse.State.Raw, outerType, region)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+// ModifyPlan plans the top-level `region` attribute, defaulting to the configured AWS Region.
+// A change of Region requires replacement.
+func (r *regionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy plan.
+	if request.Plan.Raw.IsNull() {
+		if v, ok := r.inner.(resource.ResourceWithModifyPlan); ok {
+			r.modifyPlan(ctx, v, request, response)
+		}
+
+		return
+	}
+
+	var configRegion, planRegion, stateRegion types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if configRegion.IsNull() {
+		planRegion = types.StringValue(r.meta.DefaultRegion(ctx))
+	} else {
+		planRegion = configRegion
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), planRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// State written before the `region` attribute was introduced has no value and isn't replaced.
+	if !request.State.Raw.IsNull() && stateRegion.ValueString() != "" && !planRegion.Equal(stateRegion) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+	}
+
+	response.Diagnostics.Append(setOverrideRegionInContext(ctx, response.Plan, r.meta)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v, ok := r.inner.(resource.ResourceWithModifyPlan); ok {
+		r.modifyPlan(ctx, v, request, response)
+	}
+}
+
+func (r *regionResource) modifyPlan(ctx context.Context, v resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	inner, outer := r.schemas(ctx)
+	innerType, outerType := inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+
+	config, err := withoutRegion(request.Config.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	priorState, err := withoutRegion(request.State.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	proposedNewState, err := withoutRegion(request.Plan.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	plan, err := withoutRegion(response.Plan.Raw, innerType)
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Raw: config, Schema: inner}
+	innerRequest.State = tfsdk.State{Raw: priorState, Schema: inner}
+	innerRequest.Plan = tfsdk.Plan{Raw: proposedNewState, Schema: inner}
+	innerResponse := *response
+	innerResponse.Plan = tfsdk.Plan{Raw: plan, Schema: inner}
+
+	v.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	outerPlan := response.Plan
+	*response = innerResponse
+	response.Plan = outerPlan
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan, err = withRegion(innerResponse.Plan.Raw, outerType, regionValue(outerPlan.Raw))
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+	response.Plan = tfsdk.Plan{Raw: plan, Schema: outer}
+}
+
+func (r *regionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if v, ok := r.inner.(resource.ResourceWithConfigValidators); ok {
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (r *regionResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var region types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v := region.ValueString(); v != "" {
+		if _, errs := verify.ValidRegionName(v, names.AttrRegion); len(errs) > 0 {
+			for _, err := range errs {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+			}
+
+			return
+		}
+	}
+
+	v, ok := r.inner.(resource.ResourceWithValidateConfig)
+	if !ok {
+		return
+	}
+
+	inner, _ := r.schemas(ctx)
+
+	config, err := withoutRegion(request.Config.Raw, inner.Type().TerraformType(ctx))
+	if addDiagnosticFromError(&response.Diagnostics, err) {
+		return
+	}
+
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Raw: config, Schema: inner}
+
+	v.ValidateConfig(ctx, innerRequest, response)
+}
+
+func (r *regionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	v, ok := r.inner.(resource.ResourceWithUpgradeState)
+	if !ok {
+		return nil
+	}
+
+	inner, outer := r.schemas(ctx)
+	innerType, outerType := inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+
+	upgraders := v.UpgradeState(ctx)
+	for version, upgrader := range upgraders {
+		f := upgrader.StateUpgrader
+		upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			state, err := withoutRegion(response.State.Raw, innerType)
+			if addDiagnosticFromError(&response.Diagnostics, err) {
+				return
+			}
+
+			innerResponse := *response
+			innerResponse.State = tfsdk.State{Raw: state, Schema: inner}
+
+			f(ctx, request, &innerResponse)
+
+			*response = innerResponse
+			response.State = tfsdk.State{Raw: tftypes.NewValue(outerType, nil), Schema: outer}
+			if response.Diagnostics.HasError() || innerResponse.State.Raw.IsNull() {
+				return
+			}
+
+			// The Region is set on the next refresh.
+			state, err = withRegion(innerResponse.State.Raw, outerType, tftypes.NewValue(tftypes.String, nil))
+			if addDiagnosticFromError(&response.Diagnostics, err) {
+				return
+			}
+			response.State = tfsdk.State{Raw: state, Schema: outer}
+		}
+		upgraders[version] = upgrader
+	}
+
+	return upgraders
+}
+
+func (r *regionResource) MoveState(ctx context.Context) []resource.StateMover {
+	v, ok := r.inner.(resource.ResourceWithMoveState)
+	if !ok {
+		return nil
+	}
+
+	inner, outer := r.schemas(ctx)
+	innerType, outerType := inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+
+	movers := v.MoveState(ctx)
+	for i, mover := range movers {
+		f := mover.StateMover
+		movers[i].StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+			state, err := withoutRegion(response.TargetState.Raw, innerType)
+			if addDiagnosticFromError(&response.Diagnostics, err) {
+				return
+			}
+
+			innerResponse := *response
+			innerResponse.TargetState = tfsdk.State{Raw: state, Schema: inner}
+
+			f(ctx, request, &innerResponse)
+
+			*response = innerResponse
+			response.TargetState = tfsdk.State{Raw: tftypes.NewValue(outerType, nil), Schema: outer}
+			if response.Diagnostics.HasError() || innerResponse.TargetState.Raw.IsNull() {
+				return
+			}
+
+			// The per-resource Region is moved as-is, so that the target resource is refreshed in the source resource's Region.
+			state, err = withRegion(innerResponse.TargetState.Raw, outerType, rawStateRegionValue(request.SourceRawState))
+			if addDiagnosticFromError(&response.Diagnostics, err) {
+				return
+			}
+			response.TargetState = tfsdk.State{Raw: state, Schema: outer}
+		}
+	}
+
+	return movers
+}
+
+// cutRegionSuffix splits an import ID of the form `<id>@<region>`.
+func cutRegionSuffix(s string) (string, string, bool) {
+	i := strings.LastIndex(s, "@")
+	if i < 1 {
+		return s, "", false
+	}
+
+	id, region := s[:i], s[i+1:]
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); len(errs) > 0 {
+		return s, "", false
+	}
+
+	return id, region, true
+}
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type regionTestResource struct{}
+
+func (r *regionTestResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_region_test"
+}
+
+func (r *regionTestResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *regionTestResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func (r *regionTestResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (r *regionTestResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *regionTestResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (r *regionTestResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (r *regionTestResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+}
+
+func newRegionTestResource(t *testing.T, region string) (*regionResource, tftypes.Type, tftypes.Type) {
+	t.Helper()
+
+	ctx := context.Background()
+	r := newRegionResource(&regionTestResource{}).(*regionResource)
+
+	client := &conns.AWSClient{}
+	conns.SetRegion(client, region)
+	var response resource.ConfigureResponse
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &response)
+
+	inner, outer := r.schemas(ctx)
+
+	return r, inner.Type().TerraformType(ctx), outer.Type().TerraformType(ctx)
+}
+
+func TestWithRegionWithoutRegion(t *testing.T) {
+	t.Parallel()
+
+	_, innerType, outerType := newRegionTestResource(t, "us-west-2") //lintignore:AWSAT003
+
+	innerValue := tftypes.NewValue(innerType, map[string]tftypes.Value{
+		names.AttrID:   tftypes.NewValue(tftypes.String, "id"),
+		names.AttrName: tftypes.NewValue(tftypes.String, "name"),
+	})
+	outerValue := tftypes.NewValue(outerType, map[string]tftypes.Value{
+		names.AttrID:     tftypes.NewValue(tftypes.String, "id"),
+		names.AttrName:   tftypes.NewValue(tftypes.String, "name"),
+		names.AttrRegion: tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+	})
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		typ      tftypes.Type
+		f        func(tftypes.Value, tftypes.Type) (tftypes.Value, error)
+		expected tftypes.Value
+	}{
+		"withoutRegion null": {
+			value:    tftypes.NewValue(outerType, nil),
+			typ:      innerType,
+			f:        withoutRegion,
+			expected: tftypes.NewValue(innerType, nil),
+		},
+		"withoutRegion unknown": {
+			value:    tftypes.NewValue(outerType, tftypes.UnknownValue),
+			typ:      innerType,
+			f:        withoutRegion,
+			expected: tftypes.NewValue(innerType, tftypes.UnknownValue),
+		},
+		"withoutRegion known": {
+			value:    outerValue,
+			typ:      innerType,
+			f:        withoutRegion,
+			expected: innerValue,
+		},
+		"withRegion null": {
+			value: tftypes.NewValue(innerType, nil),
+			typ:   outerType,
+			f: func(v tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+				return withRegion(v, typ, tftypes.NewValue(tftypes.String, "eu-west-1")) //lintignore:AWSAT003
+			},
+			expected: tftypes.NewValue(outerType, nil),
+		},
+		"withRegion unknown": {
+			value: tftypes.NewValue(innerType, tftypes.UnknownValue),
+			typ:   outerType,
+			f: func(v tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+				return withRegion(v, typ, tftypes.NewValue(tftypes.String, "eu-west-1")) //lintignore:AWSAT003
+			},
+			expected: tftypes.NewValue(outerType, tftypes.UnknownValue),
+		},
+		"withRegion known": {
+			value: innerValue,
+			typ:   outerType,
+			f: func(v tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+				return withRegion(v, typ, tftypes.NewValue(tftypes.String, "eu-west-1")) //lintignore:AWSAT003
+			},
+			expected: outerValue,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.f(testCase.value, testCase.typ)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestRegionResourceModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		configRegion            tftypes.Value
+		stateRegion             tftypes.Value
+		create                  bool
+		expectedPlanRegion      string
+		expectedRequiresReplace bool
+	}{
+		"create default Region": {
+			configRegion:       tftypes.NewValue(tftypes.String, nil),
+			create:             true,
+			expectedPlanRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		"create configured Region": {
+			configRegion:       tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+			create:             true,
+			expectedPlanRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"update same Region": {
+			configRegion:       tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+			stateRegion:        tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+			expectedPlanRegion: "eu-west-1",                                   //lintignore:AWSAT003
+		},
+		"update default Region unchanged": {
+			configRegion:       tftypes.NewValue(tftypes.String, nil),
+			stateRegion:        tftypes.NewValue(tftypes.String, "us-west-2"), //lintignore:AWSAT003
+			expectedPlanRegion: "us-west-2",                                   //lintignore:AWSAT003
+		},
+		"update Region changed": {
+			configRegion:            tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+			stateRegion:             tftypes.NewValue(tftypes.String, "us-west-2"), //lintignore:AWSAT003
+			expectedPlanRegion:      "eu-west-1",                                   //lintignore:AWSAT003
+			expectedRequiresReplace: true,
+		},
+		"update Region removed from configuration": {
+			configRegion:            tftypes.NewValue(tftypes.String, nil),
+			stateRegion:             tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+			expectedPlanRegion:      "us-west-2",                                   //lintignore:AWSAT003
+			expectedRequiresReplace: true,
+		},
+		"update state without Region": {
+			configRegion:       tftypes.NewValue(tftypes.String, nil),
+			stateRegion:        tftypes.NewValue(tftypes.String, nil),
+			expectedPlanRegion: "us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, _, outerType := newRegionTestResource(t, "us-west-2") //lintignore:AWSAT003
+			_, outer := r.schemas(ctx)
+
+			id := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+			if !testCase.create {
+				id = tftypes.NewValue(tftypes.String, "id")
+			}
+			config := tftypes.NewValue(outerType, map[string]tftypes.Value{
+				names.AttrID:     tftypes.NewValue(tftypes.String, nil),
+				names.AttrName:   tftypes.NewValue(tftypes.String, "name"),
+				names.AttrRegion: testCase.configRegion,
+			})
+			plan := tftypes.NewValue(outerType, map[string]tftypes.Value{
+				names.AttrID:     id,
+				names.AttrName:   tftypes.NewValue(tftypes.String, "name"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+			state := tftypes.NewValue(outerType, nil)
+			if !testCase.create {
+				state = tftypes.NewValue(outerType, map[string]tftypes.Value{
+					names.AttrID:     id,
+					names.AttrName:   tftypes.NewValue(tftypes.String, "name"),
+					names.AttrRegion: testCase.stateRegion,
+				})
+			}
+
+			request := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Raw: config, Schema: outer},
+				Plan:   tfsdk.Plan{Raw: plan, Schema: outer},
+				State:  tfsdk.State{Raw: state, Schema: outer},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			r.ModifyPlan(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+
+			if got, want := regionValue(response.Plan.Raw), tftypes.NewValue(tftypes.String, testCase.expectedPlanRegion); !got.Equal(want) {
+				t.Errorf("planned region = %s, want %s", got, want)
+			}
+
+			if got, want := len(response.RequiresReplace) > 0, testCase.expectedRequiresReplace; got != want {
+				t.Errorf("RequiresReplace = %v, want %t", response.RequiresReplace, want)
+			}
+		})
+	}
+}
+
+func TestRegionResourceImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		id             string
+		expectedID     string
+		expectedRegion tftypes.Value
+	}{
+		"no suffix": {
+			id:             "id",
+			expectedID:     "id",
+			expectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+		"Region suffix": {
+			id:             "id@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "id",
+			expectedRegion: tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+		},
+		"ID containing @": {
+			id:             "user@example.com@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "user@example.com",
+			expectedRegion: tftypes.NewValue(tftypes.String, "eu-west-1"), //lintignore:AWSAT003
+		},
+		"invalid Region suffix": {
+			id:             "user@example.com",
+			expectedID:     "user@example.com",
+			expectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, _, outerType := newRegionTestResource(t, "us-west-2") //lintignore:AWSAT003
+			_, outer := r.schemas(ctx)
+
+			request := resource.ImportStateRequest{
+				ID: testCase.id,
+			}
+			response := resource.ImportStateResponse{
+				State: tfsdk.State{Raw: tftypes.NewValue(outerType, nil), Schema: outer},
+			}
+
+			r.ImportState(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+
+			var vals map[string]tftypes.Value
+			if err := response.State.Raw.As(&vals); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := vals[names.AttrID], tftypes.NewValue(tftypes.String, testCase.expectedID); !got.Equal(want) {
+				t.Errorf("id = %s, want %s", got, want)
+			}
+
+			if got, want := vals[names.AttrRegion], testCase.expectedRegion; !got.Equal(want) {
+				t.Errorf("region = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRawStateRegionValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rawState *tfprotov6.RawState
+		expected tftypes.Value
+	}{
+		"nil": {
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"flatmap": {
+			rawState: &tfprotov6.RawState{Flatmap: map[string]string{names.AttrRegion: "eu-west-1"}}, //lintignore:AWSAT003
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"no region": {
+			rawState: &tfprotov6.RawState{JSON: []byte(`{"id":"id"}`)},
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"null region": {
+			rawState: &tfprotov6.RawState{JSON: []byte(`{"id":"id","region":null}`)},
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"region": {
+			rawState: &tfprotov6.RawState{JSON: []byte(`{"id":"id","region":"eu-west-1"}`)}, //lintignore:AWSAT003
+			expected: tftypes.NewValue(tftypes.String, "eu-west-1"),                         //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := rawStateRegionValue(testCase.rawState), testCase.expected; !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -5,7 +5,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -110,7 +113,8 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
-		diags = f(ctx, d, meta)
+		// Keep any warnings from Before interceptors.
+		diags = append(diags, f(ctx, d, meta)...)
 
 		if diags.HasError() {
 			when = OnError
@@ -382,6 +386,87 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 	return ctx, diags
 }
 
+// deletionGuardResourceInterceptor prevents deletion of resources tagged as protected by the provider's deletion_guard configuration.
+type deletionGuardResourceInterceptor struct{}
+
+func (r deletionGuardResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	deletionGuardConfig := c.DeletionGuardConfig(ctx)
+	if deletionGuardConfig == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Delete:
+			v, ok := d.Get(names.AttrTagsAll).(map[string]interface{})
+			if !ok {
+				return ctx, diags
+			}
+
+			if !deletionGuardConfig.Protects(tftags.New(ctx, v)) {
+				return ctx, diags
+			}
+
+			serviceName, resourceName := "<service>", "<thing>"
+			if inContext, ok := conns.FromContext(ctx); ok {
+				if v, err := names.HumanFriendly(inContext.ServicePackageName); err == nil {
+					serviceName = v
+				}
+				if v := inContext.ResourceName; v != "" {
+					resourceName = v
+				}
+			}
+
+			return ctx, append(diags, errs.NewErrorDiagnostic(
+				fmt.Sprintf("deleting %s %s (%s): protected by deletion guard", serviceName, resourceName, d.Id()),
+				deletionGuardConfig.ErrorDetail(),
+			))
+		}
+	}
+
+	return ctx, diags
+}
+
+// authorizationMessageInterceptor decodes any encoded authorization failure message in error diagnostics.
+// Decoding requires the sts:DecodeAuthorizationMessage permission.
+type authorizationMessageInterceptor struct{}
+
+func (r authorizationMessageInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case OnError:
+		diags = errs.DecodeAuthorizationMessages(ctx, diags, func(ctx context.Context, encodedMessage string) (string, error) {
+			return decodeAuthorizationMessage(ctx, c.STSClient(ctx), encodedMessage)
+		})
+	}
+
+	return ctx, diags
+}
+
+func decodeAuthorizationMessage(ctx context.Context, conn *sts.Client, encodedMessage string) (string, error) {
+	input := &sts.DecodeAuthorizationMessageInput{
+		EncodedMessage: aws.String(encodedMessage),
+	}
+
+	output, err := conn.DecodeAuthorizationMessage(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.DecodedMessage), nil
+}
+
 // tagsResourceInterceptor implements transparent tagging for data sources.
 type tagsDataSourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
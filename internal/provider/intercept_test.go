@@ -9,7 +9,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestInterceptorsWhy(t *testing.T) {
@@ -93,3 +96,146 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestInterceptedHandlerBeforeDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	var interceptors interceptorItems
+
+	interceptors = append(interceptors, interceptorItem{
+		when: Before,
+		why:  Create,
+		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			return ctx, sdkdiag.AppendWarningf(diags, "before warning")
+		}),
+	})
+
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	testCases := map[string]struct {
+		create       schema.CreateContextFunc
+		wantWarnings int
+		wantErrors   int
+	}{
+		"success": {
+			create: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return nil
+			},
+			wantWarnings: 1,
+		},
+		"error": {
+			create: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				var diags diag.Diagnostics
+				return sdkdiag.AppendErrorf(diags, "create error")
+			},
+			wantWarnings: 1,
+			wantErrors:   1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := interceptedHandler(bootstrapContext, interceptors, testCase.create, Create)(context.Background(), nil, 42)
+			if got, want := len(sdkdiag.Warnings(diags)), testCase.wantWarnings; got != want {
+				t.Errorf("length of warnings = %v, want %v", got, want)
+			}
+			if got, want := len(sdkdiag.Errors(diags)), testCase.wantErrors; got != want {
+				t.Errorf("length of errors = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestDeletionGuardResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		deletionGuardConfig *tftags.DeletionGuardConfig
+		tagsAll             map[string]any
+		why                 why
+		expectError         bool
+	}{
+		{
+			name: "no config",
+			tagsAll: map[string]any{
+				"protect": "true",
+			},
+			why: Delete,
+		},
+		{
+			name: "not protected",
+			deletionGuardConfig: &tftags.DeletionGuardConfig{
+				TagKey: "protect",
+			},
+			tagsAll: map[string]any{
+				"key1": "value1",
+			},
+			why: Delete,
+		},
+		{
+			name: "protected",
+			deletionGuardConfig: &tftags.DeletionGuardConfig{
+				TagKey: "protect",
+			},
+			tagsAll: map[string]any{
+				"protect": "true",
+			},
+			why:         Delete,
+			expectError: true,
+		},
+		{
+			name: "protected value not matching",
+			deletionGuardConfig: &tftags.DeletionGuardConfig{
+				TagKey:   "protect",
+				TagValue: "true",
+			},
+			tagsAll: map[string]any{
+				"protect": "false",
+			},
+			why: Delete,
+		},
+		{
+			name: "protected update",
+			deletionGuardConfig: &tftags.DeletionGuardConfig{
+				TagKey: "protect",
+			},
+			tagsAll: map[string]any{
+				"protect": "true",
+			},
+			why: Update,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				names.AttrTagsAll: {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			}, map[string]any{
+				names.AttrTagsAll: testCase.tagsAll,
+			})
+			d.SetId("test-id")
+
+			client := &conns.AWSClient{}
+			conns.SetDeletionGuardConfig(client, testCase.deletionGuardConfig)
+
+			_, diags := deletionGuardResourceInterceptor{}.run(ctx, d, client, Before, testCase.why, nil)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError() = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// sdkStateMovers returns the Plugin SDK resource state movers implemented by service packages, keyed by target resource type name.
+func sdkStateMovers(ctx context.Context, servicePackages []conns.ServicePackage) map[string][]*types.ServicePackageSDKResourceStateMover {
+	stateMovers := make(map[string][]*types.ServicePackageSDKResourceStateMover)
+
+	for _, sp := range servicePackages {
+		if v, ok := sp.(conns.ServicePackageWithSDKResourceStateMovers); ok {
+			for _, stateMover := range v.SDKResourceStateMovers(ctx) {
+				stateMovers[stateMover.TargetTypeName] = append(stateMovers[stateMover.TargetTypeName], stateMover)
+			}
+		}
+	}
+
+	return stateMovers
+}
+
+// moveStateProviderServer wraps the Plugin SDK provider server, adding support for moving resource state across resource types.
+// The Plugin SDK returns an error for all MoveResourceState requests.
+type moveStateProviderServer struct {
+	tfprotov5.ProviderServer
+	provider    *schema.Provider
+	stateMovers map[string][]*types.ServicePackageSDKResourceStateMover
+}
+
+func newMoveStateProviderServer(provider *schema.Provider, stateMovers map[string][]*types.ServicePackageSDKResourceStateMover) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &moveStateProviderServer{
+			ProviderServer: provider.GRPCProvider(),
+			provider:       provider,
+			stateMovers:    stateMovers,
+		}
+	}
+}
+
+func (s *moveStateProviderServer) MoveResourceState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	stateMover := s.stateMover(request)
+
+	if stateMover == nil {
+		return s.ProviderServer.MoveResourceState(ctx, request)
+	}
+
+	ctx = tflog.SetField(ctx, "tf_source_resource_type", request.SourceTypeName)
+	ctx = tflog.SetField(ctx, "tf_target_resource_type", request.TargetTypeName)
+	tflog.Debug(ctx, "Moving resource state")
+
+	response := &tfprotov5.MoveResourceStateResponse{}
+
+	targetState, err := moveResourceState(ctx, stateMover, request.SourceState)
+
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Unable to Move Resource State",
+			Detail:   fmt.Sprintf("moving %s state to %s: %s", request.SourceTypeName, request.TargetTypeName, err),
+		})
+
+		return response, nil
+	}
+
+	// Coerce the moved state to the target resource's schema.
+	// Attributes not in the target resource's schema are removed and missing attributes are set to null.
+	upgradeResponse, err := s.ProviderServer.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		RawState: &tfprotov5.RawState{JSON: targetState},
+		TypeName: request.TargetTypeName,
+		Version:  int64(s.provider.ResourcesMap[request.TargetTypeName].SchemaVersion),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.Diagnostics = append(response.Diagnostics, upgradeResponse.Diagnostics...)
+	response.TargetState = upgradeResponse.UpgradedState
+
+	return response, nil
+}
+
+// stateMover returns the state mover for the specified request, or nil if the move is not supported.
+func (s *moveStateProviderServer) stateMover(request *tfprotov5.MoveResourceStateRequest) *types.ServicePackageSDKResourceStateMover {
+	if _, ok := s.provider.ResourcesMap[request.TargetTypeName]; !ok {
+		return nil
+	}
+
+	if !strings.HasSuffix(request.SourceProviderAddress, "hashicorp/aws") {
+		return nil
+	}
+
+	for _, v := range s.stateMovers[request.TargetTypeName] {
+		if v.SourceTypeName == request.SourceTypeName && int64(v.SourceSchemaVersion) == request.SourceSchemaVersion {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// moveResourceState returns the JSON-encoded target resource state from the source resource state.
+func moveResourceState(ctx context.Context, stateMover *types.ServicePackageSDKResourceStateMover, sourceState *tfprotov5.RawState) ([]byte, error) {
+	if sourceState == nil || len(sourceState.JSON) == 0 {
+		return nil, errors.New("source resource state is not JSON-encoded")
+	}
+
+	var source map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(sourceState.JSON))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&source); err != nil {
+		return nil, fmt.Errorf("decoding source resource state: %w", err)
+	}
+
+	target, err := stateMover.StateMover(ctx, source)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The per-resource Region is moved as-is.
+	if v, ok := source[names.AttrRegion]; ok {
+		if _, ok := target[names.AttrRegion]; !ok {
+			target[names.AttrRegion] = v
+		}
+	}
+
+	return json.Marshal(target)
+}
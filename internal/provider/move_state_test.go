@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestMoveStateProviderServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test_target": {
+				Schema: map[string]*schema.Schema{
+					"count": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					names.AttrRegion: {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+	stateMovers := map[string][]*types.ServicePackageSDKResourceStateMover{
+		"aws_test_target": {
+			{
+				SourceTypeName:      "aws_test_source",
+				SourceSchemaVersion: 1,
+				TargetTypeName:      "aws_test_target",
+				StateMover: func(_ context.Context, source map[string]any) (map[string]any, error) {
+					if source["source_name"] == "fail" {
+						return nil, errors.New("failed")
+					}
+
+					return map[string]any{
+						names.AttrID:   source[names.AttrID],
+						names.AttrName: source["source_name"],
+						"count":        source["source_count"],
+						"unknown":      "removed",
+					}, nil
+				},
+			},
+		},
+	}
+	server := newMoveStateProviderServer(provider, stateMovers)()
+	targetType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"count":          tftypes.Number,
+			names.AttrID:     tftypes.String,
+			names.AttrName:   tftypes.String,
+			names.AttrRegion: tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		sourceProviderAddress string
+		sourceTypeName        string
+		sourceSchemaVersion   int64
+		sourceState           string
+		expected              tftypes.Value
+		wantError             bool
+	}{
+		"moved": {
+			sourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+			sourceTypeName:        "aws_test_source",
+			sourceSchemaVersion:   1,
+			sourceState:           `{"id":"test","source_name":"example","source_count":5,"region":"us-west-2"}`, //lintignore:AWSAT003
+			expected: tftypes.NewValue(targetType, map[string]tftypes.Value{
+				"count":          tftypes.NewValue(tftypes.Number, 5),
+				names.AttrID:     tftypes.NewValue(tftypes.String, "test"),
+				names.AttrName:   tftypes.NewValue(tftypes.String, "example"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, "us-west-2"), //lintignore:AWSAT003
+			}),
+		},
+		"missing attributes": {
+			sourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+			sourceTypeName:        "aws_test_source",
+			sourceSchemaVersion:   1,
+			sourceState:           `{"id":"test","source_name":"example"}`,
+			expected: tftypes.NewValue(targetType, map[string]tftypes.Value{
+				"count":          tftypes.NewValue(tftypes.Number, nil),
+				names.AttrID:     tftypes.NewValue(tftypes.String, "test"),
+				names.AttrName:   tftypes.NewValue(tftypes.String, "example"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"state mover error": {
+			sourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+			sourceTypeName:        "aws_test_source",
+			sourceSchemaVersion:   1,
+			sourceState:           `{"id":"test","source_name":"fail"}`,
+			wantError:             true,
+		},
+		"unsupported source schema version": {
+			sourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+			sourceTypeName:        "aws_test_source",
+			sourceSchemaVersion:   0,
+			sourceState:           `{"id":"test","source_name":"example"}`,
+			wantError:             true,
+		},
+		"unsupported source resource type": {
+			sourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+			sourceTypeName:        "aws_test_other",
+			sourceSchemaVersion:   1,
+			sourceState:           `{"id":"test","source_name":"example"}`,
+			wantError:             true,
+		},
+		"unsupported source provider": {
+			sourceProviderAddress: "registry.terraform.io/example/aws",
+			sourceTypeName:        "aws_test_source",
+			sourceSchemaVersion:   1,
+			sourceState:           `{"id":"test","source_name":"example"}`,
+			wantError:             true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response, err := server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: testCase.sourceProviderAddress,
+				SourceSchemaVersion:   testCase.sourceSchemaVersion,
+				SourceState:           &tfprotov5.RawState{JSON: []byte(testCase.sourceState)},
+				SourceTypeName:        testCase.sourceTypeName,
+				TargetTypeName:        "aws_test_target",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(response.Diagnostics) > 0, testCase.wantError; got != want {
+				t.Fatalf("MoveResourceState() diagnostics = %v, want error = %t", response.Diagnostics, want)
+			}
+
+			if testCase.wantError {
+				return
+			}
+
+			got, err := response.TargetState.Unmarshal(targetType)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// planWarningsProviderServer wraps the Plugin SDK provider server, returning any warnings added to Context while planning.
+// Plugin SDK CustomizeDiff functions can only return errors.
+type planWarningsProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func newPlanWarningsProviderServer(f func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &planWarningsProviderServer{
+			ProviderServer: f(),
+		}
+	}
+}
+
+func (s *planWarningsProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx = sdkdiag.NewWarningsContext(ctx)
+
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if response != nil {
+		for _, v := range sdkdiag.WarningsFromContext(ctx) {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  v.Summary,
+				Detail:   v.Detail,
+			})
+		}
+	}
+
+	return response, err
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+type planWarningsTestProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s planWarningsTestProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	sdkdiag.AddWarningToContext(ctx, "summary", "detail")
+
+	return &tfprotov5.PlanResourceChangeResponse{}, nil
+}
+
+func TestPlanWarningsProviderServer(t *testing.T) {
+	t.Parallel()
+
+	server := newPlanWarningsProviderServer(func() tfprotov5.ProviderServer {
+		return planWarningsTestProviderServer{}
+	})()
+
+	response, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(response.Diagnostics), 1; got != want {
+		t.Fatalf("length of diagnostics = %v, want %v", got, want)
+	}
+
+	if got, want := response.Diagnostics[0], (&tfprotov5.Diagnostic{Severity: tfprotov5.DiagnosticSeverityWarning, Summary: "summary", Detail: "detail"}); *got != *want {
+		t.Errorf("diagnostic = %v, want %v", got, want)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -68,6 +71,44 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						names.AttrRule: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with resource tags to default across the resource types matching the rule. Later rules take precedence.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource type name patterns, e.g. `aws_s3_bucket_*`, to which the rule does not apply.",
+									},
+									"include_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource type name patterns, e.g. `aws_s3_*`, to which the rule applies. If not set, the rule applies to all resource types.",
+									},
+									names.AttrName: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Name of the rule, used to identify the rule in logs.",
+									},
+									"services": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Services, e.g. `ec2`, to which the rule applies. If not set, the rule applies to all services.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across the resource types matching the rule.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -78,6 +119,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"deletion_guard": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to prevent deletion of tagged resources across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Resource tag key identifying resources that cannot be deleted.",
+						},
+						"tag_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Resource tag value identifying resources that cannot be deleted. If not set, any value matches. " +
+								"The deletion guard can be disabled with the " + tftags.DeletionGuardDisabledEnvVar + " environment variable.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -130,6 +192,40 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_patterns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Glob patterns, e.g. `kubernetes.io/cluster/*`, matching resource tag keys to ignore across all resources.",
+						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"tag_pattern": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with glob patterns matching the key and value of resource tags to ignore across all resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Glob pattern matching the resource tag key.",
+									},
+									names.AttrValue: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Glob pattern matching the resource tag value.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -191,6 +287,44 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with AWS API request limits for individual services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_concurrent_requests": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of in-flight requests to the service's API.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of times a request to the service's API is attempted. Overrides the provider's `max_retries`.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Description:  "The maximum rate of requests to the service's API.",
+							ValidateFunc: validation.FloatAtLeast(0.01),
+						},
+						"retry_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Specifies how requests to the service's API are retried. Valid values are `standard` and `adaptive`. Overrides the provider's `retry_mode`.",
+							ValidateFunc: validation.StringInSlice(enum.Slice(aws.RetryModeStandard, aws.RetryModeAdaptive), false),
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service, e.g. `route53`. Any service name accepted in the `endpoints` block can be used.",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -209,6 +343,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Skip the credentials validation via STS API. " +
 					"Used for AWS API implementations that do not have STS available/implemented.",
 			},
+			"skip_data_source_caching": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Skip caching the results of idempotent data sources, such as aws_caller_identity, " +
+					"for the duration of a Terraform operation.",
+			},
 			"skip_metadata_api_check": {
 				Type:         nullable.TypeNullableBool,
 				Optional:     true,
@@ -301,7 +441,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        OnError,
+					why:         Read,
+					interceptor: authorizationMessageInterceptor{},
+				},
+			}
+
+			regionOverrideEnabled := isRegionOverrideEnabled(servicePackageName, r.SchemaMap())
+			if regionOverrideEnabled {
+				addRegionAttribute(r, dataSourceRegionSchema())
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionDataSourceInterceptor{},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -371,13 +528,30 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx).ForResourceType(servicePackageName, typeName), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        OnError,
+					why:         AllOps,
+					interceptor: authorizationMessageInterceptor{},
+				},
+			}
+
+			regionOverrideEnabled := isRegionOverrideEnabled(servicePackageName, r.SchemaMap())
+			if regionOverrideEnabled {
+				addRegionAttribute(r, resourceRegionSchema())
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionResourceInterceptor{},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -414,6 +588,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if _, ok := r.SchemaMap()[names.AttrTagsAll]; ok {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before,
+					why:         Delete,
+					interceptor: deletionGuardResourceInterceptor{},
+				})
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -433,9 +615,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
+					if regionOverrideEnabled {
+						v = importRegion(v)
+					}
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
+			if regionOverrideEnabled {
+				r.CustomizeDiff = withRegionCustomizeDiff(r.CustomizeDiff)
+			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
@@ -492,6 +680,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipDataSourceCaching:          d.Get("skip_data_source_caching").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
@@ -560,11 +749,28 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		config.DefaultTagsConfig = expandDefaultTags(ctx, tfMap)
+
+		if v, ok := tfMap[names.AttrRule].([]interface{}); ok && len(v) > 0 {
+			rules, dx := expandDefaultTagsRules(ctx, v)
+			diags = append(diags, dx...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			if config.DefaultTagsConfig == nil {
+				config.DefaultTagsConfig = &tftags.DefaultConfig{}
+			}
+			config.DefaultTagsConfig.Rules = rules
+		}
 	} else {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("deletion_guard"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DeletionGuardConfig = expandDeletionGuard(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -592,16 +798,30 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.NoProxy = v
 	}
 
+	var ignoreTagsConfig map[string]interface{}
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
-	} else {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
+		ignoreTagsConfig = v.([]interface{})[0].(map[string]interface{})
+	}
+	ignoreConfig, dx := expandIgnoreTags(ctx, ignoreTagsConfig)
+	diags = append(diags, dx...)
+	if diags.HasError() {
+		return nil, diags
 	}
+	config.IgnoreTagsConfig = ignoreConfig
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_limits"); ok && len(v.([]interface{})) > 0 {
+		serviceLimits, dx := expandServiceLimits(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceLimits = serviceLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -894,7 +1114,128 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return nil
 }
 
-func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
+func expandDefaultTagsRules(ctx context.Context, tfList []interface{}) ([]tftags.DefaultTagsRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules := make([]tftags.DefaultTagsRule, 0, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := tftags.DefaultTagsRule{
+			// Unnamed rules are identified by their position.
+			Name: strconv.Itoa(i),
+		}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			rule.Name = v
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+			rule.Tags = tftags.New(ctx, v)
+		}
+
+		if v, ok := tfMap["services"].(*schema.Set); ok {
+			servicesPath := cty.GetAttrPath("default_tags").IndexInt(0).GetAttr(names.AttrRule).IndexInt(i).GetAttr("services")
+			for _, service := range flex.ExpandStringValueSet(v) {
+				servicePackageName, err := names.ProviderPackageForAlias(service)
+				if err != nil {
+					diags = append(diags, errs.NewInvalidValueAttributeErrorf(servicesPath, "Unsupported service %q", service))
+					continue
+				}
+				rule.Services = append(rule.Services, servicePackageName)
+			}
+		}
+
+		if v, ok := tfMap["include_resource_types"].(*schema.Set); ok {
+			rule.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+			rule.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, diags
+}
+
+func expandDeletionGuard(ctx context.Context, tfMap map[string]interface{}) *tftags.DeletionGuardConfig {
+	if v, err := strconv.ParseBool(os.Getenv(tftags.DeletionGuardDisabledEnvVar)); err == nil && v {
+		tflog.Warn(ctx, "deletion_guard disabled", map[string]any{
+			"env_var": tftags.DeletionGuardDisabledEnvVar,
+		})
+
+		return nil
+	}
+
+	deletionGuardConfig := &tftags.DeletionGuardConfig{}
+
+	if v, ok := tfMap["tag_key"].(string); ok {
+		deletionGuardConfig.TagKey = v
+	}
+
+	if v, ok := tfMap["tag_value"].(string); ok {
+		deletionGuardConfig.TagValue = v
+	}
+
+	return deletionGuardConfig
+}
+
+func expandServiceLimits(_ context.Context, tfList []interface{}) (map[string]conns.ServiceLimits, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceLimits := make(map[string]conns.ServiceLimits)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		servicePath := cty.GetAttrPath("service_limits").IndexInt(i).GetAttr("service")
+		service := tfMap["service"].(string)
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(servicePath, "Unsupported service %q", service))
+			continue
+		}
+
+		if _, ok := serviceLimits[servicePackageName]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(servicePath, "Duplicate limits for service %q", service))
+			continue
+		}
+
+		limits := conns.ServiceLimits{}
+
+		if v, ok := tfMap["max_concurrent_requests"].(int); ok {
+			limits.MaxConcurrentRequests = v
+		}
+
+		if v, ok := tfMap["max_retries"].(int); ok {
+			limits.MaxRetries = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			limits.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+			limits.RetryMode = aws.RetryMode(v)
+		}
+
+		serviceLimits[servicePackageName] = limits
+	}
+
+	return serviceLimits, diags
+}
+
+func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.IgnoreConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	var keys, keyPrefixes []interface{}
 
 	if tfMap != nil {
@@ -922,16 +1263,54 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		}
 	}
 
+	var keyPatterns []string
+	var keyRegexps []*regexp.Regexp
+	var tagPatterns []tftags.IgnoreTagPattern
+
+	if tfMap != nil {
+		if v, ok := tfMap["key_patterns"].(*schema.Set); ok {
+			keyPatterns = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+			keyRegexesPath := cty.GetAttrPath("ignore_tags").IndexInt(0).GetAttr("key_regexes")
+			for _, v := range flex.ExpandStringValueSet(v) {
+				re, err := regexp.Compile(v)
+				if err != nil {
+					diags = append(diags, errs.NewInvalidValueAttributeErrorf(keyRegexesPath, "Invalid regular expression %q: %s", v, err))
+					continue
+				}
+				keyRegexps = append(keyRegexps, re)
+			}
+		}
+		if v, ok := tfMap["tag_pattern"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				tagPatterns = append(tagPatterns, tftags.IgnoreTagPattern{
+					Key:   tfMap[names.AttrKey].(string),
+					Value: tfMap[names.AttrValue].(string),
+				})
+			}
+		}
+	}
+
 	// To preseve behavior prior to supporting environment variables:
 	//
-	// - Return nil when no keys or prefixes are set
+	// - Return nil when no keys, prefixes or patterns are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
-		return nil
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(keyPatterns) == 0 && len(keyRegexps) == 0 && len(tagPatterns) == 0 {
+		return nil, diags
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	ignoreConfig := &tftags.IgnoreConfig{
+		KeyPatterns: keyPatterns,
+		KeyRegexps:  keyRegexps,
+		TagPatterns: tagPatterns,
+	}
 	if len(keys) > 0 {
 		ignoreConfig.Keys = tftags.New(ctx, keys)
 	}
@@ -939,7 +1318,7 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, keyPrefixes)
 	}
 
-	return ignoreConfig
+	return ignoreConfig, diags
 }
 
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
@@ -6,13 +6,16 @@ package provider
 import (
 	"context"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -307,6 +310,93 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDefaultTagsRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList        []interface{}
+		expectedRules []tftags.DefaultTagsRule
+		expectError   bool
+	}{
+		"empty": {
+			tfList:        []interface{}{},
+			expectedRules: []tftags.DefaultTagsRule{},
+		},
+		"rules": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					names.AttrName:           "storage",
+					"tags":                   map[string]interface{}{"Backup": "daily"},
+					"services":               schema.NewSet(schema.HashString, []interface{}{"s3"}),
+					"include_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_s3_*"}),
+					"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_s3_object"}),
+				},
+				map[string]interface{}{
+					"tags": map[string]interface{}{"Compliance": "pci"},
+				},
+			},
+			expectedRules: []tftags.DefaultTagsRule{
+				{
+					Name:                 "storage",
+					Tags:                 tftags.New(ctx, map[string]string{"Backup": "daily"}),
+					Services:             []string{names.S3},
+					IncludeResourceTypes: []string{"aws_s3_*"},
+					ExcludeResourceTypes: []string{"aws_s3_object"},
+				},
+				{
+					Name: "1",
+					Tags: tftags.New(ctx, map[string]string{"Compliance": "pci"}),
+				},
+			},
+		},
+		"service alias": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"tags":     map[string]interface{}{"Team": "observability"},
+					"services": schema.NewSet(schema.HashString, []interface{}{"prometheus"}),
+				},
+			},
+			expectedRules: []tftags.DefaultTagsRule{
+				{
+					Name:     "0",
+					Tags:     tftags.New(ctx, map[string]string{"Team": "observability"}),
+					Services: []string{names.AMP},
+				},
+			},
+		},
+		"unsupported service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"tags":     map[string]interface{}{"Team": "platform"},
+					"services": schema.NewSet(schema.HashString, []interface{}{"unknown"}),
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandDefaultTagsRules(ctx, testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("HasError() = %t, want %t: %v", got, want, diags)
+			}
+
+			if testcase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(testcase.expectedRules, results); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
@@ -406,11 +496,15 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 				os.Setenv(k, v)
 			}
 
-			results := expandIgnoreTags(ctx, map[string]interface{}{
+			results, diags := expandIgnoreTags(ctx, map[string]interface{}{
 				"keys":         schema.NewSet(schema.HashString, testcase.keys),
 				"key_prefixes": schema.NewSet(schema.HashString, testcase.keyPrefixes),
 			})
 
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
 			if results == nil && testcase.expectedIgnoreConfig != nil {
 				t.Errorf("Expected ignore tags config to be %v, got nil", testcase.expectedIgnoreConfig)
 			}
@@ -422,6 +516,174 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandIgnoreTagsPatterns(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	results, diags := expandIgnoreTags(ctx, map[string]interface{}{
+		"key_patterns": schema.NewSet(schema.HashString, []interface{}{"kubernetes.io/cluster/*"}),
+		"key_regexes":  schema.NewSet(schema.HashString, []interface{}{`^aws:cloudformation:.+$`}),
+		"tag_pattern": []interface{}{
+			map[string]interface{}{
+				names.AttrKey:   "created-by",
+				names.AttrValue: "karpenter*",
+			},
+		},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := &tftags.IgnoreConfig{
+		KeyPatterns: []string{"kubernetes.io/cluster/*"},
+		KeyRegexps:  []*regexp.Regexp{regexp.MustCompile(`^aws:cloudformation:.+$`)},
+		TagPatterns: []tftags.IgnoreTagPattern{
+			{
+				Key:   "created-by",
+				Value: "karpenter*",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, results, cmp.Comparer(func(x, y *regexp.Regexp) bool { return x.String() == y.String() })); diff != "" {
+		t.Errorf("Unexpected ignore_tags diff: %s", diff)
+	}
+}
+
+func TestExpandIgnoreTagsInvalidKeyRegex(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	_, diags := expandIgnoreTags(ctx, map[string]interface{}{
+		"key_regexes": schema.NewSet(schema.HashString, []interface{}{`^aws:cloudformation:.+$`, `^kubernetes.io/(`}),
+	})
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, want := len(diags), 1; got != want {
+		t.Errorf("expected %d diagnostics, got %d: %v", want, got, diags)
+	}
+
+	if got, want := diags[0].AttributePath, cty.GetAttrPath("ignore_tags").IndexInt(0).GetAttr("key_regexes"); !got.Equals(want) {
+		t.Errorf("expected attribute path %#v, got %#v", want, got)
+	}
+}
+
+func TestExpandServiceLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList                []interface{}
+		expectedServiceLimits map[string]conns.ServiceLimits
+		expectError           bool
+	}{
+		"empty": {
+			tfList:                []interface{}{},
+			expectedServiceLimits: map[string]conns.ServiceLimits{},
+		},
+		"single service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":                 "route53",
+					"max_concurrent_requests": 2,
+					"max_retries":             0,
+					"requests_per_second":     5.0,
+					"retry_mode":              "adaptive",
+				},
+			},
+			expectedServiceLimits: map[string]conns.ServiceLimits{
+				names.Route53: {
+					MaxConcurrentRequests: 2,
+					RequestsPerSecond:     5,
+					RetryMode:             aws.RetryModeAdaptive,
+				},
+			},
+		},
+		"multiple services": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":     "iam",
+					"max_retries": 10,
+				},
+				map[string]interface{}{
+					"service":                 "organizations",
+					"max_concurrent_requests": 1,
+				},
+			},
+			expectedServiceLimits: map[string]conns.ServiceLimits{
+				names.IAM: {
+					MaxRetries: 10,
+				},
+				names.Organizations: {
+					MaxConcurrentRequests: 1,
+				},
+			},
+		},
+		"service alias": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":             "prometheus",
+					"requests_per_second": 0.5,
+				},
+			},
+			expectedServiceLimits: map[string]conns.ServiceLimits{
+				names.AMP: {
+					RequestsPerSecond: 0.5,
+				},
+			},
+		},
+		"unsupported service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service": "unknown",
+				},
+			},
+			expectError: true,
+		},
+		"duplicate service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":     "route53",
+					"max_retries": 10,
+				},
+				map[string]interface{}{
+					"service":     "route53",
+					"max_retries": 5,
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandServiceLimits(ctx, testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("HasError() = %t, want %t: %v", got, want, diags)
+			}
+
+			if testcase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(testcase.expectedServiceLimits, results); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
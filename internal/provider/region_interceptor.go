@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionAttributeDescription = "Region where this resource will be managed. Defaults to the Region set in the provider configuration."
+)
+
+// isRegionOverrideEnabled returns whether the top-level `region` attribute can be added to the specified schema.
+// Resources and data sources in global service packages or whose schema already defines `region` are not eligible.
+func isRegionOverrideEnabled(servicePackageName string, s map[string]*schema.Schema) bool {
+	if names.IsGlobalService(servicePackageName) {
+		return false
+	}
+
+	_, ok := s[names.AttrRegion]
+
+	return !ok
+}
+
+// addRegionAttribute adds the top-level `region` attribute to the specified resource's schema.
+func addRegionAttribute(r *schema.Resource, s *schema.Schema) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+			return m
+		}
+	} else {
+		r.Schema[names.AttrRegion] = s
+	}
+}
+
+func dataSourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  regionAttributeDescription,
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+func resourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		Description:  regionAttributeDescription,
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+// setOverrideRegionInContext sets any configured value of the top-level `region` attribute as the per-resource Region override.
+func setOverrideRegionInContext(ctx context.Context, d interface{ Get(string) any }, meta any) error {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+		inContext.OverrideRegion = v
+	}
+
+	return meta.(*conns.AWSClient).ValidateInContextRegionInPartition(ctx)
+}
+
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if err := setOverrideRegionInContext(ctx, d, meta); err != nil {
+			return ctx, sdkdiag.AppendFromErr(diags, err)
+		}
+	case After:
+		if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region(ctx)); err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		// State written before the `region` attribute was introduced has no value.
+		// In that case the configured AWS Region is used.
+		if err := setOverrideRegionInContext(ctx, d, meta); err != nil {
+			return ctx, sdkdiag.AppendFromErr(diags, err)
+		}
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			// Existing state is upgraded to carry the Region on the first refresh.
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionCustomizeDiff plans the top-level `region` attribute, defaulting to the configured AWS Region.
+func regionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() && config.GetAttr(names.AttrRegion).IsNull() {
+		defaultRegion := meta.(*conns.AWSClient).DefaultRegion(ctx)
+
+		// Don't plan replacement of existing resources whose state does not yet carry a Region.
+		if o, n := d.GetChange(names.AttrRegion); d.Id() == "" || (o.(string) != "" && n.(string) != defaultRegion) {
+			if err := d.SetNew(names.AttrRegion, defaultRegion); err != nil {
+				return err
+			}
+		}
+	}
+
+	return setOverrideRegionInContext(ctx, d, meta)
+}
+
+// withRegionCustomizeDiff prepends regionCustomizeDiff to any resource CustomizeDiff function.
+func withRegionCustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if f == nil {
+		return regionCustomizeDiff
+	}
+
+	return customdiff.Sequence(regionCustomizeDiff, f)
+}
+
+// importRegion returns an import handler that supports an optional `@<region>` suffix on the import ID.
+// The suffix is removed from the ID and used as the per-resource Region override.
+func importRegion(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region, ok := cutRegionSuffix(d.Id()); ok {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+
+			if err := setOverrideRegionInContext(ctx, d, meta); err != nil {
+				return nil, err
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// cutRegionSuffix splits an import ID of the form `<id>@<region>`.
+func cutRegionSuffix(s string) (string, string, bool) {
+	i := strings.LastIndex(s, "@")
+	if i < 1 {
+		return s, "", false
+	}
+
+	id, region := s[:i], s[i+1:]
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); len(errs) > 0 {
+		return s, "", false
+	}
+
+	return id, region, true
+}
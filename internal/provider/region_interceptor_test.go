@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestCutRegionSuffix(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		input          string
+		expectedID     string
+		expectedRegion string
+		expectedOK     bool
+	}{
+		{
+			name:       "no suffix",
+			input:      "sg-12345678",
+			expectedID: "sg-12345678",
+		},
+		{
+			name:           "region suffix",
+			input:          "sg-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "sg-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		{
+			name:           "multiple separators",
+			input:          "user@example.com@us-east-2", //lintignore:AWSAT003
+			expectedID:     "user@example.com",
+			expectedRegion: "us-east-2", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		{
+			name:       "invalid region suffix",
+			input:      "user@example.com",
+			expectedID: "user@example.com",
+		},
+		{
+			name:       "empty ID",
+			input:      "@us-east-2", //lintignore:AWSAT003
+			expectedID: "@us-east-2", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, ok := cutRegionSuffix(testCase.input)
+
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("id: got %q, expected %q", got, want)
+			}
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("region: got %q, expected %q", got, want)
+			}
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok: got %t, expected %t", got, want)
+			}
+		})
+	}
+}
@@ -87,9 +87,10 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	conns.SetDefaultTagsConfig(conn, expandDefaultTags(context.Background(), map[string]interface{}{
 		"tag": "",
 	}))
-	conns.SetIgnoreTagsConfig(conn, expandIgnoreTags(context.Background(), map[string]interface{}{
+	ignoreConfig, _ := expandIgnoreTags(context.Background(), map[string]interface{}{
 		"tag2": "tag",
-	}))
+	})
+	conns.SetIgnoreTagsConfig(conn, ignoreConfig)
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
 		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
 		Name: "aws_directory_service_directory",
 		F:    sweepDirectories,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_directory_service_region", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_region", &resource.Sweeper{
 		Name: "aws_directory_service_region",
 		F:    sweepRegions,
 	})
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Environment variable enabling the coalescing of concurrent EC2 resource lookups by ID
+	//
+	// The value is the duration to wait for further lookups before a single filtered
+	// Describe call is made, e.g. `50ms`, or `true` to use the default duration.
+	CoalesceReadsEnvVar = "TF_AWS_EC2_COALESCE_READS"
+
+	defaultCoalesceReadsWindow = 25 * time.Millisecond
+
+	// The maximum number of values in an EC2 API filter.
+	maxCoalescedReadIDs = 200
+)
+
+// coalesceReadsWindow returns the duration to wait for further lookups, or 0 if lookups are not coalesced.
+var coalesceReadsWindow = sync.OnceValue(func() time.Duration {
+	v := os.Getenv(CoalesceReadsEnvVar)
+	if v == "" {
+		return 0
+	}
+
+	if b, err := strconv.ParseBool(v); err == nil {
+		if b {
+			return defaultCoalesceReadsWindow
+		}
+		return 0
+	}
+
+	if d, err := time.ParseDuration(v); err == nil && d > 0 {
+		return d
+	}
+
+	return 0
+})
+
+var (
+	ebsVolumeReads = newCoalescedReads(
+		func(ctx context.Context, conn *ec2.Client, ids []string) ([]awstypes.Volume, error) {
+			return findEBSVolumes(ctx, conn, &ec2.DescribeVolumesInput{
+				Filters: []awstypes.Filter{newFilter("volume-id", ids)},
+			})
+		},
+		func(v *awstypes.Volume) string { return aws.ToString(v.VolumeId) },
+		func(output []awstypes.Volume) (*awstypes.Volume, error) {
+			return tfresource.AssertSingleValueResult(output)
+		},
+	)
+	instanceReads = newCoalescedReads(
+		func(ctx context.Context, conn *ec2.Client, ids []string) ([]awstypes.Instance, error) {
+			return findInstances(ctx, conn, &ec2.DescribeInstancesInput{
+				Filters: []awstypes.Filter{newFilter("instance-id", ids)},
+			})
+		},
+		func(v *awstypes.Instance) string { return aws.ToString(v.InstanceId) },
+		func(output []awstypes.Instance) (*awstypes.Instance, error) {
+			return tfresource.AssertSingleValueResult(output, func(v *awstypes.Instance) bool { return v.State != nil })
+		},
+	)
+	networkInterfaceReads = newCoalescedReads(
+		func(ctx context.Context, conn *ec2.Client, ids []string) ([]awstypes.NetworkInterface, error) {
+			return findNetworkInterfaces(ctx, conn, &ec2.DescribeNetworkInterfacesInput{
+				Filters: []awstypes.Filter{newFilter("network-interface-id", ids)},
+			})
+		},
+		func(v *awstypes.NetworkInterface) string { return aws.ToString(v.NetworkInterfaceId) },
+		func(output []awstypes.NetworkInterface) (*awstypes.NetworkInterface, error) {
+			return tfresource.AssertSingleValueResult(output)
+		},
+	)
+	securityGroupReads = newCoalescedReads(
+		func(ctx context.Context, conn *ec2.Client, ids []string) ([]awstypes.SecurityGroup, error) {
+			return findSecurityGroups(ctx, conn, &ec2.DescribeSecurityGroupsInput{
+				Filters: []awstypes.Filter{newFilter("group-id", ids)},
+			})
+		},
+		func(v *awstypes.SecurityGroup) string { return aws.ToString(v.GroupId) },
+		func(output []awstypes.SecurityGroup) (*awstypes.SecurityGroup, error) {
+			return tfresource.AssertSingleValueResult(output)
+		},
+	)
+)
+
+// coalescedReads groups concurrent lookups of EC2 resources by ID into a single filtered Describe call.
+// Lookups are grouped per API client, i.e. per AWS Region.
+// Filtering by ID, unlike specifying IDs in the request, does not fail if any of the resources does not exist.
+type coalescedReads[T any] struct {
+	describe func(context.Context, *ec2.Client, []string) ([]T, error)
+	id       func(*T) string
+	single   func([]T) (*T, error)
+	window   func() time.Duration
+
+	lock    sync.Mutex
+	pending map[*ec2.Client]*coalescedReadBatch[T]
+}
+
+// coalescedReadBatch is a single Describe call made on behalf of one or more lookups.
+type coalescedReadBatch[T any] struct {
+	ids     map[string]struct{}
+	once    sync.Once
+	done    chan struct{}
+	count   int
+	results map[string][]T
+	err     error
+}
+
+func newCoalescedReads[T any](describe func(context.Context, *ec2.Client, []string) ([]T, error), id func(*T) string, single func([]T) (*T, error)) *coalescedReads[T] {
+	return &coalescedReads[T]{
+		describe: describe,
+		id:       id,
+		single:   single,
+		window:   coalesceReadsWindow,
+		pending:  make(map[*ec2.Client]*coalescedReadBatch[T]),
+	}
+}
+
+// enabled returns whether lookups are coalesced.
+func (r *coalescedReads[T]) enabled() bool {
+	return r.window() > 0
+}
+
+// find returns the resource with the specified ID.
+// The lookup waits for other lookups made using the same API client within the configured window.
+func (r *coalescedReads[T]) find(ctx context.Context, conn *ec2.Client, id string) (*T, error) {
+	r.lock.Lock()
+	batch, ok := r.pending[conn]
+	if !ok {
+		batch = &coalescedReadBatch[T]{
+			ids:  make(map[string]struct{}),
+			done: make(chan struct{}),
+		}
+		r.pending[conn] = batch
+
+		time.AfterFunc(r.window(), func() {
+			r.run(conn, batch)
+		})
+	}
+	batch.ids[id] = struct{}{}
+	if len(batch.ids) >= maxCoalescedReadIDs {
+		// The batch is full.
+		delete(r.pending, conn)
+		go r.run(conn, batch)
+	}
+	r.lock.Unlock()
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	tflog.Debug(ctx, "Coalesced EC2 resource lookup", map[string]any{
+		"count": batch.count,
+	})
+
+	if batch.err != nil {
+		return nil, batch.err
+	}
+
+	// Each lookup gets its own copy of the results, so a caller can modify the returned value.
+	return r.single(slices.Clone(batch.results[id]))
+}
+
+// run makes the batch's Describe call once and notifies all waiting lookups.
+// The call is made on behalf of all of the batch's lookups, so its Context is not derived from any lookup's Context.
+// It is not cancelled with, attributed to the resource of, or logged with the fields of the lookup that started the batch.
+func (r *coalescedReads[T]) run(conn *ec2.Client, batch *coalescedReadBatch[T]) {
+	batch.once.Do(func() {
+		ctx := context.Background()
+
+		r.lock.Lock()
+		if r.pending[conn] == batch {
+			delete(r.pending, conn)
+		}
+		ids := make([]string, 0, len(batch.ids))
+		for id := range batch.ids {
+			ids = append(ids, id)
+		}
+		r.lock.Unlock()
+
+		output, err := r.describe(ctx, conn, ids)
+
+		batch.count = len(ids)
+		batch.err = err
+		batch.results = make(map[string][]T, len(ids))
+		for _, v := range output {
+			id := r.id(&v)
+			batch.results[id] = append(batch.results[id], v)
+		}
+
+		close(batch.done)
+	})
+}
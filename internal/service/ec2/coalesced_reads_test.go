@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCoalescedReadsFind(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var calls atomic.Int32
+	var lock sync.Mutex
+	var describedIDs []string
+	reads := newCoalescedReads(
+		func(_ context.Context, _ *ec2.Client, ids []string) ([]awstypes.Volume, error) {
+			calls.Add(1)
+			lock.Lock()
+			describedIDs = append(describedIDs, ids...)
+			lock.Unlock()
+
+			var output []awstypes.Volume
+			for _, id := range ids {
+				// The "missing" volume does not exist.
+				if id != "vol-missing" {
+					output = append(output, awstypes.Volume{VolumeId: aws.String(id)})
+				}
+			}
+			return output, nil
+		},
+		func(v *awstypes.Volume) string { return aws.ToString(v.VolumeId) },
+		func(output []awstypes.Volume) (*awstypes.Volume, error) {
+			return tfresource.AssertSingleValueResult(output)
+		},
+	)
+	reads.window = func() time.Duration { return 50 * time.Millisecond }
+
+	ids := []string{"vol-1", "vol-2", "vol-3", "vol-1", "vol-missing"}
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			output, err := reads.find(ctx, nil, id)
+			if err == nil && aws.ToString(output.VolumeId) != id {
+				err = errors.New("unexpected volume ID: " + aws.ToString(output.VolumeId))
+			}
+			errs[i] = err
+		}()
+	}
+	wg.Wait()
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("Describe calls = %d, want %d", got, want)
+	}
+
+	slices.Sort(describedIDs)
+	if got, want := describedIDs, []string{"vol-1", "vol-2", "vol-3", "vol-missing"}; !slices.Equal(got, want) {
+		t.Errorf("described IDs = %v, want %v", got, want)
+	}
+
+	for i, id := range ids {
+		if id == "vol-missing" {
+			if !tfresource.NotFound(errs[i]) {
+				t.Errorf("%s: expected NotFound error, got %v", id, errs[i])
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Errorf("%s: unexpected error: %s", id, errs[i])
+		}
+	}
+}
+
+func TestCoalescedReadsFindIsolation(t *testing.T) {
+	t.Parallel()
+
+	var describeInContext atomic.Bool
+	reads := newCoalescedReads(
+		func(ctx context.Context, _ *ec2.Client, ids []string) ([]awstypes.Volume, error) {
+			_, ok := conns.FromContext(ctx)
+			describeInContext.Store(ok)
+
+			output := make([]awstypes.Volume, 0, len(ids))
+			for _, id := range ids {
+				output = append(output, awstypes.Volume{VolumeId: aws.String(id)})
+			}
+			return output, nil
+		},
+		func(v *awstypes.Volume) string { return aws.ToString(v.VolumeId) },
+		func(output []awstypes.Volume) (*awstypes.Volume, error) {
+			return tfresource.AssertSingleValueResult(output)
+		},
+	)
+	reads.window = func() time.Duration { return 50 * time.Millisecond }
+
+	outputs := make([]*awstypes.Volume, 2)
+	var wg sync.WaitGroup
+	for i := range outputs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx := conns.NewResourceContext(context.Background(), names.EC2, "EBS Volume")
+			output, err := reads.find(ctx, nil, "vol-1")
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			outputs[i] = output
+		}()
+	}
+	wg.Wait()
+
+	if describeInContext.Load() {
+		t.Error("Describe call made in a lookup's resource Context")
+	}
+
+	if outputs[0] == nil || outputs[1] == nil {
+		t.Fatal("expected volumes")
+	}
+
+	if outputs[0] == outputs[1] {
+		t.Error("lookups share the same volume")
+	}
+}
+
+func TestCoalescedReadsFindError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	describeErr := errors.New("describe error")
+	reads := newCoalescedReads(
+		func(context.Context, *ec2.Client, []string) ([]awstypes.Volume, error) {
+			return nil, describeErr
+		},
+		func(v *awstypes.Volume) string { return aws.ToString(v.VolumeId) },
+		func(output []awstypes.Volume) (*awstypes.Volume, error) {
+			return tfresource.AssertSingleValueResult(output)
+		},
+	)
+	reads.window = func() time.Duration { return time.Millisecond }
+
+	if _, err := reads.find(ctx, nil, "vol-1"); !errors.Is(err, describeErr) {
+		t.Errorf("expected describe error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	reads.window = func() time.Duration { return time.Hour }
+
+	if _, err := reads.find(ctx, nil, "vol-1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled error, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ package ec2
 import (
 	"cmp"
 	"context"
+	"encoding/json"
 	"log"
 	"slices"
 	"time"
@@ -102,15 +103,28 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 		request.Filters = nil
 	}
 
-	log.Printf("[DEBUG] Reading Availability Zones: %s", d.Id())
-	resp, err := conn.DescribeAvailabilityZones(ctx, request)
+	arguments, err := json.Marshal(request)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "fetching Availability Zones: %s", err)
 	}
 
-	slices.SortFunc(resp.AvailabilityZones, func(a, b awstypes.AvailabilityZone) int {
-		return cmp.Compare(aws.ToString(a.ZoneName), aws.ToString(b.ZoneName))
+	log.Printf("[DEBUG] Reading Availability Zones: %s", d.Id())
+	resp, err := conns.ReadDataSourceCached(ctx, meta.(*conns.AWSClient), "aws_availability_zones", string(arguments), func(ctx context.Context) (*ec2.DescribeAvailabilityZonesOutput, error) {
+		output, err := conn.DescribeAvailabilityZones(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		// Sort before caching as the cached output is shared.
+		slices.SortFunc(output.AvailabilityZones, func(a, b awstypes.AvailabilityZone) int {
+			return cmp.Compare(aws.ToString(a.ZoneName), aws.ToString(b.ZoneName))
+		})
+
+		return output, nil
 	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "fetching Availability Zones: %s", err)
+	}
 
 	excludeNames := d.Get("exclude_names").(*schema.Set)
 	excludeZoneIDs := d.Get("exclude_zone_ids").(*schema.Set)
@@ -447,7 +447,14 @@ func findInstanceByID(ctx context.Context, conn *ec2.Client, id string) (*awstyp
 		InstanceIds: []string{id},
 	}
 
-	output, err := findInstance(ctx, conn, input)
+	var output *awstypes.Instance
+	var err error
+
+	if instanceReads.enabled() {
+		output, err = instanceReads.find(ctx, conn, id)
+	} else {
+		output, err = findInstance(ctx, conn, input)
+	}
 
 	if err != nil {
 		return nil, err
@@ -1947,7 +1954,14 @@ func findSecurityGroupByID(ctx context.Context, conn *ec2.Client, id string) (*a
 		GroupIds: []string{id},
 	}
 
-	output, err := findSecurityGroup(ctx, conn, input)
+	var output *awstypes.SecurityGroup
+	var err error
+
+	if securityGroupReads.enabled() {
+		output, err = securityGroupReads.find(ctx, conn, id)
+	} else {
+		output, err = findSecurityGroup(ctx, conn, input)
+	}
 
 	if err != nil {
 		return nil, err
@@ -2120,7 +2134,14 @@ func findNetworkInterfaceByID(ctx context.Context, conn *ec2.Client, id string)
 		NetworkInterfaceIds: []string{id},
 	}
 
-	output, err := findNetworkInterface(ctx, conn, input)
+	var output *awstypes.NetworkInterface
+	var err error
+
+	if networkInterfaceReads.enabled() {
+		output, err = networkInterfaceReads.find(ctx, conn, id)
+	} else {
+		output, err = findNetworkInterface(ctx, conn, input)
+	}
 
 	if err != nil {
 		return nil, err
@@ -2243,7 +2264,14 @@ func findEBSVolumeByID(ctx context.Context, conn *ec2.Client, id string) (*awsty
 		VolumeIds: []string{id},
 	}
 
-	output, err := findEBSVolume(ctx, conn, input)
+	var output *awstypes.Volume
+	var err error
+
+	if ebsVolumeReads.enabled() {
+		output, err = ebsVolumeReads.find(ctx, conn, id)
+	} else {
+		output, err = findEBSVolume(ctx, conn, input)
+	}
 
 	if err != nil {
 		return nil, err
@@ -32,7 +32,12 @@ func (*securityGroupEgressRuleResource) Metadata(_ context.Context, request reso
 }
 
 func (*securityGroupEgressRuleResource) MoveState(ctx context.Context) []resource.StateMover {
-	return []resource.StateMover{}
+	return []resource.StateMover{
+		{
+			SourceSchema: legacySecurityGroupRuleResourceSchemaV2(ctx),
+			StateMover:   moveStateResourceSecurityGroupRule(securityGroupRuleTypeEgress),
+		},
+	}
 }
 
 func (r *securityGroupEgressRuleResource) create(ctx context.Context, data *securityGroupRuleResourceModel) (string, error) {
@@ -63,7 +63,7 @@ func (r *securityGroupIngressRuleResource) MoveState(ctx context.Context) []reso
 	return []resource.StateMover{
 		{
 			SourceSchema: legacySecurityGroupRuleResourceSchemaV2(ctx),
-			StateMover:   r.moveStateResourceSecurityGroupRule,
+			StateMover:   moveStateResourceSecurityGroupRule(securityGroupRuleTypeIngress),
 		},
 	}
 }
@@ -103,63 +103,6 @@ func (r *securityGroupIngressRuleResource) findByID(ctx context.Context, id stri
 	return findSecurityGroupIngressRuleByID(ctx, conn, id)
 }
 
-// moveStateResourceSecurityGroupRule transforms the state of an `aws_security_group_rule` resource to this resource's schema.
-func (r *securityGroupIngressRuleResource) moveStateResourceSecurityGroupRule(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
-	if request.SourceTypeName != "aws_security_group_rule" {
-		return
-	}
-
-	if request.SourceSchemaVersion != 2 {
-		return
-	}
-
-	if !strings.HasSuffix(request.SourceProviderAddress, "hashicorp/aws") {
-		return
-	}
-
-	var source legacySecurityGroupRuleResourceModel
-	response.Diagnostics.Append(request.SourceState.Get(ctx, &source)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
-
-	// TODO: Need to find the security group rule ID.
-
-	// if typ := source.Type.ValueEnum(); typ != securityGroupRuleTypeIngress {
-	// 	response.Diagnostics.AddError("Incorrect Type", string(typ))
-	// 	return
-	// }
-
-	// nCIDRs := 0
-	// if !source.CIDRBlocks.IsNull() {
-	// 	nCIDRs += len(source.CIDRBlocks.Elements())
-	// }
-	// nIPv6CIDRs := 0
-	// if !source.IPv6CIDRBlocksBlocks.IsNull() {
-	// 	nIPv6CIDRs += len(source.IPv6CIDRBlocksBlocks.Elements())
-	// }
-	// nPrefxListIDs := 0
-	// if !source.PrefixListIDs.IsNull() {
-	// 	nPrefxListIDs += len(source.PrefixListIDs.Elements())
-	// }
-	// nSourceSecurityGroupIDs := 0
-	// if !source.SourceSecurityGroupID.IsNull() && source.SourceSecurityGroupID.ValueString() != "" {
-	// 	nSourceSecurityGroupIDs = 1
-	// }
-
-	// if nCIDRs+nIPv6CIDRs+nPrefxListIDs+nSourceSecurityGroupIDs > 1 {
-	// 	response.Diagnostics.AddError("Multiple Sources", "Only one source is allowed")
-	// 	return
-	// }
-
-	// target := &securityGroupRuleResourceModel{
-	// 	// ARN: 				  r.securityGroupRuleARN(ctx, securityGroupRuleID),
-	// 	Description: fwflex.EmptyStringAsNull(source.Description),
-	// }
-
-	// response.Diagnostics.Append(response.TargetState.Set(ctx, target)...)
-}
-
 // Base structure and methods for VPC security group rules.
 
 type securityGroupRule interface {
@@ -417,6 +360,110 @@ func (r *securityGroupRuleResource) securityGroupRuleARN(ctx context.Context, id
 	return types.StringValue(r.Meta().RegionalARN(ctx, names.EC2, fmt.Sprintf("security-group-rule/%s", id)))
 }
 
+// moveStateResourceSecurityGroupRule returns a state mover that transforms the state of an `aws_security_group_rule` resource
+// of the specified type to this resource's schema.
+func moveStateResourceSecurityGroupRule(ruleType securityGroupRuleType) func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse) {
+	return func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+		if request.SourceTypeName != "aws_security_group_rule" {
+			return
+		}
+
+		if request.SourceSchemaVersion != 2 {
+			return
+		}
+
+		if !strings.HasSuffix(request.SourceProviderAddress, "hashicorp/aws") {
+			return
+		}
+
+		var source legacySecurityGroupRuleResourceModel
+		response.Diagnostics.Append(request.SourceState.Get(ctx, &source)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		target, diags := securityGroupRuleFromLegacy(ctx, &source, ruleType)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(response.TargetState.Set(ctx, target)...)
+	}
+}
+
+// securityGroupRuleFromLegacy transforms the state of an `aws_security_group_rule` resource to a VPC security group rule.
+// Only rules with a single source, as identified by `security_group_rule_id`, can be transformed.
+func securityGroupRuleFromLegacy(ctx context.Context, source *legacySecurityGroupRuleResourceModel, ruleType securityGroupRuleType) (*securityGroupRuleResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if typ := source.Type.ValueEnum(); typ != ruleType {
+		diags.AddError("Incorrect Security Group Rule Type", fmt.Sprintf("cannot move %s security group rule (%s) to an %s rule", typ, source.ID.ValueString(), ruleType))
+
+		return nil, diags
+	}
+
+	// aws_security_group_rule sets security_group_rule_id only if the rule corresponds to a single VPC security group rule.
+	if source.SecurityGroupRuleID.ValueString() == "" {
+		diags.AddError("Missing Security Group Rule ID", fmt.Sprintf("security group rule (%s) does not correspond to a single VPC security group rule, only rules with a single CIDR block, prefix list or security group can be moved", source.ID.ValueString()))
+
+		return nil, diags
+	}
+
+	target := &securityGroupRuleResourceModel{
+		// The ARN is set on the next refresh.
+		ARN:                       types.StringNull(),
+		CIDRIPv4:                  types.StringNull(),
+		CIDRIPv6:                  types.StringNull(),
+		Description:               fwflex.EmptyStringAsNull(source.Description),
+		FromPort:                  source.FromPort,
+		IPProtocol:                source.Protocol,
+		PrefixListID:              types.StringNull(),
+		ReferencedSecurityGroupID: types.StringNull(),
+		SecurityGroupID:           source.SecurityGroupID,
+		SecurityGroupRuleID:       source.SecurityGroupRuleID,
+		Tags:                      tftags.Null,
+		TagsAll:                   tftags.Null,
+		ToPort:                    source.ToPort,
+	}
+	target.setID()
+
+	// Ports are not specified for rules for all protocols.
+	if protocolForValue(source.Protocol.ValueString()) == "-1" {
+		target.FromPort = types.Int64Null()
+		target.ToPort = types.Int64Null()
+	}
+
+	var sources []string
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, source.CIDRBlocks) {
+		target.CIDRIPv4 = types.StringValue(v)
+		sources = append(sources, v)
+	}
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, source.IPv6CIDRBlocksBlocks) {
+		target.CIDRIPv6 = types.StringValue(v)
+		sources = append(sources, v)
+	}
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, source.PrefixListIDs) {
+		target.PrefixListID = types.StringValue(v)
+		sources = append(sources, v)
+	}
+	if source.Self.ValueBool() {
+		target.ReferencedSecurityGroupID = source.SecurityGroupID
+		sources = append(sources, source.SecurityGroupID.ValueString())
+	} else if v := source.SourceSecurityGroupID.ValueString(); v != "" {
+		target.ReferencedSecurityGroupID = types.StringValue(v)
+		sources = append(sources, v)
+	}
+
+	if n := len(sources); n != 1 {
+		diags.AddError("Multiple Sources", fmt.Sprintf("security group rule (%s) has %d sources, only rules with a single CIDR block, prefix list or security group can be moved", source.ID.ValueString(), n))
+
+		return nil, diags
+	}
+
+	return target, diags
+}
+
 func flattenReferencedSecurityGroup(ctx context.Context, apiObject *awstypes.ReferencedSecurityGroup, accountID string) types.String {
 	if apiObject == nil {
 		return types.StringNull()
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestSecurityGroupRuleFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stringList := func(v ...string) fwtypes.ListValueOf[types.String] {
+		if len(v) == 0 {
+			return fwtypes.NewListValueOfNull[types.String](ctx)
+		}
+
+		var elements []attr.Value
+		for _, v := range v {
+			elements = append(elements, types.StringValue(v))
+		}
+
+		return fwtypes.NewListValueOfMust[types.String](ctx, elements)
+	}
+	legacy := func(ruleType securityGroupRuleType, protocol string, fromPort, toPort int64) legacySecurityGroupRuleResourceModel {
+		return legacySecurityGroupRuleResourceModel{
+			CIDRBlocks:            stringList(),
+			Description:           types.StringValue(""),
+			FromPort:              types.Int64Value(fromPort),
+			ID:                    types.StringValue("sgrule-1234567890"),
+			IPv6CIDRBlocksBlocks:  stringList(),
+			PrefixListIDs:         stringList(),
+			Protocol:              ipProtocolValue(protocol),
+			SecurityGroupID:       types.StringValue("sg-11111111"),
+			SecurityGroupRuleID:   types.StringValue("sgr-22222222"),
+			Self:                  types.BoolValue(false),
+			SourceSecurityGroupID: types.StringValue(""),
+			ToPort:                types.Int64Value(toPort),
+			Type:                  fwtypes.StringEnumValue(ruleType),
+		}
+	}
+	expected := func(protocol string, fromPort, toPort types.Int64) securityGroupRuleResourceModel {
+		return securityGroupRuleResourceModel{
+			ARN:                       types.StringNull(),
+			CIDRIPv4:                  types.StringNull(),
+			CIDRIPv6:                  types.StringNull(),
+			Description:               types.StringNull(),
+			FromPort:                  fromPort,
+			ID:                        types.StringValue("sgr-22222222"),
+			IPProtocol:                ipProtocolValue(protocol),
+			PrefixListID:              types.StringNull(),
+			ReferencedSecurityGroupID: types.StringNull(),
+			SecurityGroupID:           types.StringValue("sg-11111111"),
+			SecurityGroupRuleID:       types.StringValue("sgr-22222222"),
+			Tags:                      tftags.Null,
+			TagsAll:                   tftags.Null,
+			ToPort:                    toPort,
+		}
+	}
+
+	testCases := map[string]struct {
+		source    func() legacySecurityGroupRuleResourceModel
+		ruleType  securityGroupRuleType
+		expected  func() securityGroupRuleResourceModel
+		wantError bool
+	}{
+		"IPv4 CIDR block": {
+			source: func() legacySecurityGroupRuleResourceModel {
+				v := legacy(securityGroupRuleTypeIngress, "tcp", 443, 443)
+				v.CIDRBlocks = stringList("10.0.0.0/8")
+				v.Description = types.StringValue("HTTPS")
+				return v
+			},
+			ruleType: securityGroupRuleTypeIngress,
+			expected: func() securityGroupRuleResourceModel {
+				v := expected("tcp", types.Int64Value(443), types.Int64Value(443))
+				v.CIDRIPv4 = types.StringValue("10.0.0.0/8")
+				v.Description = types.StringValue("HTTPS")
+				return v
+			},
+		},
+		"IPv6 CIDR block all protocols": {
+			source: func() legacySecurityGroupRuleResourceModel {
+				v := legacy(securityGroupRuleTypeEgress, "-1", 0, 0)
+				v.IPv6CIDRBlocksBlocks = stringList("::/0")
+				return v
+			},
+			ruleType: securityGroupRuleTypeEgress,
+			expected: func() securityGroupRuleResourceModel {
+				v := expected("-1", types.Int64Null(), types.Int64Null())
+				v.CIDRIPv6 = types.StringValue("::/0")
+				return v
+			},
+		},
+		"prefix list": {
+			source: func() legacySecurityGroupRuleResourceModel {
+				v := legacy(securityGroupRuleTypeEgress, "tcp", 80, 80)
+				v.PrefixListIDs = stringList("pl-12345678")
+				return v
+			},
+			ruleType: securityGroupRuleTypeEgress,
+			expected: func() securityGroupRuleResourceModel {
+				v := expected("tcp", types.Int64Value(80), types.Int64Value(80))
+				v.PrefixListID = types.StringValue("pl-12345678")
+				return v
+			},
+		},
+		"self": {
+			source: func() legacySecurityGroupRuleResourceModel {
+				v := legacy(securityGroupRuleTypeIngress, "udp", 53, 53)
+				v.Self = types.BoolValue(true)
+				return v
+			},
+			ruleType: securityGroupRuleTypeIngress,
+			expected: func() securityGroupRuleResourceModel {
+				v := expected("udp", types.Int64Value(53), types.Int64Value(53))
+				v.ReferencedSecurityGroupID = types.StringValue("sg-11111111")
+				return v
+			},
+		},
+		"source security group": {
+			source: func() legacySecurityGroupRuleResourceModel {
+				v := legacy(securityGroupRuleTypeIngress, "tcp", 22, 22)
+				v.SourceSecurityGroupID = types.StringValue("123456789012/sg-33333333")
+				return v
+			},
+			ruleType: securityGroupRuleTypeIngress,
+			expected: func() securityGroupRuleResourceModel {
+				v := expected("tcp", types.Int64Value(22), types.Int64Value(22))
+				v.ReferencedSecurityGroupID = types.StringValue("123456789012/sg-33333333")
+				return v
+			},
+		},
+		"incorrect type": {
+			source: func() legacySecurityGroupRuleResourceModel {
+				v := legacy(securityGroupRuleTypeEgress, "tcp", 443, 443)
+				v.CIDRBlocks = stringList("10.0.0.0/8")
+				return v
+			},
+			ruleType:  securityGroupRuleTypeIngress,
+			wantError: true,
+		},
+		"multiple sources": {
+			source: func() legacySecurityGroupRuleResourceModel {
+				v := legacy(securityGroupRuleTypeIngress, "tcp", 443, 443)
+				v.CIDRBlocks = stringList("10.0.0.0/8", "172.16.0.0/12")
+				v.SecurityGroupRuleID = types.StringValue("")
+				return v
+			},
+			ruleType:  securityGroupRuleTypeIngress,
+			wantError: true,
+		},
+		"IPv4 and IPv6 CIDR blocks": {
+			source: func() legacySecurityGroupRuleResourceModel {
+				v := legacy(securityGroupRuleTypeIngress, "tcp", 443, 443)
+				v.CIDRBlocks = stringList("10.0.0.0/8")
+				v.IPv6CIDRBlocksBlocks = stringList("::/0")
+				return v
+			},
+			ruleType:  securityGroupRuleTypeIngress,
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			source := testCase.source()
+			got, diags := securityGroupRuleFromLegacy(ctx, &source, testCase.ruleType)
+
+			if got, want := diags.HasError(), testCase.wantError; got != want {
+				t.Fatalf("securityGroupRuleFromLegacy() error = %v, want error = %t", diags, want)
+			}
+
+			if testCase.wantError {
+				return
+			}
+
+			if diff := cmp.Diff(*got, testCase.expected()); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -5,15 +5,19 @@ package ecr
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -58,27 +62,19 @@ func dataSourceAuthorizationTokenRead(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECRClient(ctx)
 
-	out, err := conn.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	authorizationData, err := findAuthorizationToken(ctx, conn)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECR Authorization Token: %s", err)
 	}
 
-	authorizationData := out.AuthorizationData[0]
 	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
 	expiresAt := aws.ToTime(authorizationData.ExpiresAt).Format(time.RFC3339)
 	proxyEndpoint := aws.ToString(authorizationData.ProxyEndpoint)
-	authBytes, err := itypes.Base64Decode(authorizationToken)
+	userName, password, err := decodeAuthorizationToken(authorizationToken)
 	if err != nil {
-		d.SetId("")
-		return sdkdiag.AppendErrorf(diags, "decoding ECR authorization token: %s", err)
-	}
-	basicAuthorization := strings.Split(string(authBytes), ":")
-	if len(basicAuthorization) != 2 {
-		return sdkdiag.AppendErrorf(diags, "unknown ECR authorization token format")
+		return sdkdiag.AppendFromErr(diags, err)
 	}
-	userName := basicAuthorization[0]
-	password := basicAuthorization[1]
 	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("authorization_token", authorizationToken)
 	d.Set("proxy_endpoint", proxyEndpoint)
@@ -88,3 +84,34 @@ func dataSourceAuthorizationTokenRead(ctx context.Context, d *schema.ResourceDat
 
 	return diags
 }
+
+func findAuthorizationToken(ctx context.Context, conn *ecr.Client) (*awstypes.AuthorizationData, error) {
+	input := &ecr.GetAuthorizationTokenInput{}
+
+	output, err := conn.GetAuthorizationToken(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AuthorizationData) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return &output.AuthorizationData[0], nil
+}
+
+// decodeAuthorizationToken returns the user name and password encoded in an ECR authorization token.
+func decodeAuthorizationToken(authorizationToken string) (string, string, error) {
+	authBytes, err := itypes.Base64Decode(authorizationToken)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding ECR authorization token: %w", err)
+	}
+
+	basicAuthorization := strings.Split(string(authBytes), ":")
+	if len(basicAuthorization) != 2 {
+		return "", "", errors.New("unknown ECR authorization token format")
+	}
+
+	return basicAuthorization[0], basicAuthorization[1], nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthorizationToken = "Ephemeral Authorization Token"
+)
+
+// @EphemeralResource(aws_ecr_authorization_token, name="Authorization Token")
+func newEphemeralAuthorizationToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthorizationToken{}, nil
+}
+
+type ephemeralAuthorizationToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthorizationToken) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_ecr_authorization_token"
+}
+
+func (e *ephemeralAuthorizationToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"proxy_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthorizationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAuthorizationTokenData
+	conn := e.Meta().ECRClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	authorizationData, err := findAuthorizationToken(ctx, conn)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ERNameAuthorizationToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
+	userName, password, err := decodeAuthorizationToken(authorizationToken)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ERNameAuthorizationToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AuthorizationToken = types.StringValue(authorizationToken)
+	data.ExpiresAt = fwflex.TimeToFramework(ctx, authorizationData.ExpiresAt)
+	data.Password = types.StringValue(password)
+	data.ProxyEndpoint = fwflex.StringToFramework(ctx, authorizationData.ProxyEndpoint)
+	data.UserName = types.StringValue(userName)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAuthorizationTokenData struct {
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	ExpiresAt          timetypes.RFC3339 `tfsdk:"expires_at"`
+	Password           types.String      `tfsdk:"password"`
+	ProxyEndpoint      types.String      `tfsdk:"proxy_endpoint"`
+	UserName           types.String      `tfsdk:"user_name"`
+}
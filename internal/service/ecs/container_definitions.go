@@ -6,13 +6,16 @@ package ecs
 import (
 	"cmp"
 	"fmt"
+	"reflect"
 	"slices"
+	"strings"
 	_ "unsafe" // Required for go:linkname
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	_ "github.com/aws/aws-sdk-go-v2/service/ecs" // Required for go:linkname
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	smithyjson "github.com/aws/smithy-go/encoding/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -45,6 +48,68 @@ func containerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool,
 	return tfjson.EqualBytes(b1, b2), nil
 }
 
+// NormalizeContainerDefinitions returns the normalized JSON form of the specified container definitions.
+// Containers, environment variables and secrets are sorted, sparse and empty arrays are removed,
+// and API defaults are applied, so that equivalent container definitions have the same normalized form.
+// isAWSVPC indicates that the containers are in a task definition with the "awsvpc" network mode.
+func NormalizeContainerDefinitions(tfString string, isAWSVPC bool) (string, error) {
+	apiObjects, err := expandContainerDefinitions(tfString)
+	if err != nil {
+		return "", err
+	}
+
+	containerDefinitions(apiObjects).reduce(isAWSVPC)
+
+	b, err := tfjson.EncodeToBytes(containerDefinitionsJSONValue(reflect.ValueOf(apiObjects)))
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
+// containerDefinitionsJSONValue returns a value that JSON encodes in the same form as the ECS API.
+// Struct fields use lower camel case member names and, as in the API, nil and zero values are omitted.
+func containerDefinitionsJSONValue(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return containerDefinitionsJSONValue(v.Elem())
+	case reflect.Struct:
+		m := make(map[string]any)
+		for i := range v.NumField() {
+			field, value := v.Type().Field(i), v.Field(i)
+			if !field.IsExported() || value.IsZero() {
+				continue
+			}
+			m[strings.ToLower(field.Name[:1])+field.Name[1:]] = containerDefinitionsJSONValue(value)
+		}
+		return m
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		s := make([]any, v.Len())
+		for i := range v.Len() {
+			s[i] = containerDefinitionsJSONValue(v.Index(i))
+		}
+		return s
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := make(map[string]any, v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			m[iter.Key().String()] = containerDefinitionsJSONValue(iter.Value())
+		}
+		return m
+	default:
+		return v.Interface()
+	}
+}
+
 type containerDefinitions []awstypes.ContainerDefinition
 
 func (cd containerDefinitions) reduce(isAWSVPC bool) {
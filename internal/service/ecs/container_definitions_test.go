@@ -727,3 +727,71 @@ func TestExpandContainerDefinitions_InvalidVersionConsistency(t *testing.T) {
 		t.Fatalf("Expected message '%[1]s', got '%[2]s'", expectedErr, err.Error())
 	}
 }
+
+func TestNormalizeContainerDefinitions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		isAWSVPC bool
+		expected string
+	}{
+		"defaults": {
+			input: `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "environment": [
+            {"name": "B", "value": "2"},
+            {"name": "A", "value": "1"}
+        ],
+        "portMappings": [
+            {"containerPort": 80, "hostPort": 0, "protocol": "tcp"}
+        ],
+        "healthCheck": {"command": ["CMD-SHELL", "true"]},
+        "mountPoints": [],
+        "volumesFrom": [{}]
+    }
+]`,
+			expected: `[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"healthCheck":{"command":["CMD-SHELL","true"],"interval":30,"retries":3,"timeout":5},"image":"wordpress","name":"wordpress","portMappings":[{"containerPort":80}]}]`,
+		},
+		"awsvpc": {
+			input: `
+[
+    {"name": "wordpress", "image": "wordpress", "essential": false, "portMappings": [{"containerPort": 80}]},
+    {"name": "mysql", "image": "mysql"}
+]`,
+			isAWSVPC: true,
+			expected: `[{"essential":true,"image":"mysql","name":"mysql"},{"essential":false,"image":"wordpress","name":"wordpress","portMappings":[{"containerPort":80,"hostPort":80}]}]`,
+		},
+		"maps and zero values": {
+			input: `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "cpu": 0,
+        "dockerLabels": {"Com.Example.Label": "value"},
+        "logConfiguration": {"logDriver": "awslogs", "options": {"awslogs-group": "wordpress"}}
+    }
+]`,
+			expected: `[{"dockerLabels":{"Com.Example.Label":"value"},"essential":true,"image":"wordpress","logConfiguration":{"logDriver":"awslogs","options":{"awslogs-group":"wordpress"}},"name":"wordpress"}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizeContainerDefinitions(testCase.input, testCase.isAWSVPC)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("Expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// SDKResourceStateMovers returns the moves from legacy resources to this service package's replacement resources.
+func (p *servicePackage) SDKResourceStateMovers(ctx context.Context) []*itypes.ServicePackageSDKResourceStateMover {
+	return []*itypes.ServicePackageSDKResourceStateMover{
+		{
+			SourceTypeName: "aws_iam_policy_attachment",
+			TargetTypeName: "aws_iam_group_policy_attachment",
+			StateMover:     moveStateFromPolicyAttachment("groups", "group"),
+		},
+		{
+			SourceTypeName: "aws_iam_policy_attachment",
+			TargetTypeName: "aws_iam_role_policy_attachment",
+			StateMover:     moveStateFromPolicyAttachment("roles", names.AttrRole),
+		},
+		{
+			SourceTypeName: "aws_iam_policy_attachment",
+			TargetTypeName: "aws_iam_user_policy_attachment",
+			StateMover:     moveStateFromPolicyAttachment("users", "user"),
+		},
+	}
+}
+
+// moveStateFromPolicyAttachment returns a state mover from an `aws_iam_policy_attachment` resource to a
+// group, role or user policy attachment resource.
+// Only policy attachments with a single group, role or user, as identified by the specified attributes, can be moved.
+func moveStateFromPolicyAttachment(sourceAttrName, targetAttrName string) func(context.Context, map[string]any) (map[string]any, error) {
+	return func(_ context.Context, source map[string]any) (map[string]any, error) {
+		name, _ := source[names.AttrName].(string)
+		policyARN, _ := source["policy_arn"].(string)
+		if policyARN == "" {
+			return nil, errors.New("policy ARN not found")
+		}
+
+		var principals []string
+		for _, k := range []string{"groups", "roles", "users"} {
+			v, _ := source[k].([]any)
+			for _, v := range v {
+				if v, ok := v.(string); ok && v != "" {
+					if k != sourceAttrName {
+						return nil, fmt.Errorf("IAM Policy Attachment (%s) has %s, only policy attachments with a single %s can be moved", name, k, targetAttrName)
+					}
+					principals = append(principals, v)
+				}
+			}
+		}
+
+		if n := len(principals); n != 1 {
+			return nil, fmt.Errorf("IAM Policy Attachment (%s) has %d %s, only policy attachments with a single %s can be moved", name, n, sourceAttrName, targetAttrName)
+		}
+
+		principal := principals[0]
+
+		return map[string]any{
+			// Same format as the import ID.
+			names.AttrID:   fmt.Sprintf("%s-%s", principal, policyARN),
+			"policy_arn":   policyARN,
+			targetAttrName: principal,
+		}, nil
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSDKResourceStateMovers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stateMovers := make(map[string]func(context.Context, map[string]any) (map[string]any, error))
+	for _, v := range (&servicePackage{}).SDKResourceStateMovers(ctx) {
+		stateMovers[v.SourceTypeName+"->"+v.TargetTypeName] = v.StateMover
+	}
+
+	const policyARN = "arn:aws:iam::aws:policy/ReadOnlyAccess" //lintignore:AWSAT005
+
+	testCases := map[string]struct {
+		move      string
+		source    map[string]any
+		expected  map[string]any
+		wantError bool
+	}{
+		"role": {
+			move: "aws_iam_policy_attachment->aws_iam_role_policy_attachment",
+			source: map[string]any{
+				"groups":     []any{},
+				"id":         "example",
+				"name":       "example",
+				"policy_arn": policyARN,
+				"roles":      []any{"example-role"},
+				"users":      nil,
+			},
+			expected: map[string]any{
+				"id":         "example-role-" + policyARN,
+				"policy_arn": policyARN,
+				"role":       "example-role",
+			},
+		},
+		"user": {
+			move: "aws_iam_policy_attachment->aws_iam_user_policy_attachment",
+			source: map[string]any{
+				"id":         "example",
+				"name":       "example",
+				"policy_arn": policyARN,
+				"users":      []any{"example-user"},
+			},
+			expected: map[string]any{
+				"id":         "example-user-" + policyARN,
+				"policy_arn": policyARN,
+				"user":       "example-user",
+			},
+		},
+		"group": {
+			move: "aws_iam_policy_attachment->aws_iam_group_policy_attachment",
+			source: map[string]any{
+				"groups":     []any{"example-group"},
+				"id":         "example",
+				"name":       "example",
+				"policy_arn": policyARN,
+			},
+			expected: map[string]any{
+				"group":      "example-group",
+				"id":         "example-group-" + policyARN,
+				"policy_arn": policyARN,
+			},
+		},
+		"multiple roles": {
+			move: "aws_iam_policy_attachment->aws_iam_role_policy_attachment",
+			source: map[string]any{
+				"id":         "example",
+				"name":       "example",
+				"policy_arn": policyARN,
+				"roles":      []any{"example-role1", "example-role2"},
+			},
+			wantError: true,
+		},
+		"role and user": {
+			move: "aws_iam_policy_attachment->aws_iam_role_policy_attachment",
+			source: map[string]any{
+				"id":         "example",
+				"name":       "example",
+				"policy_arn": policyARN,
+				"roles":      []any{"example-role"},
+				"users":      []any{"example-user"},
+			},
+			wantError: true,
+		},
+		"no roles": {
+			move: "aws_iam_policy_attachment->aws_iam_role_policy_attachment",
+			source: map[string]any{
+				"id":         "example",
+				"name":       "example",
+				"policy_arn": policyARN,
+				"users":      []any{"example-user"},
+			},
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stateMover, ok := stateMovers[testCase.move]
+			if !ok {
+				t.Fatalf("no state mover for %s", testCase.move)
+			}
+
+			got, err := stateMover(ctx, testCase.source)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("StateMover() error = %v, want error = %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -165,7 +166,7 @@ func dataSourcePolicyDocument() *schema.Resource {
 
 func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	mergedDoc := &IAMPolicyDoc{}
+	mergedDoc := &verify.IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
 		// generate sid map to assure there are no duplicates in source jsons
@@ -182,7 +183,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				continue
 			}
 
-			sourceDoc := &IAMPolicyDoc{}
+			sourceDoc := &verify.IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
 				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: %s", sourceJSONIndex, err)
 			}
@@ -202,7 +203,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	// process the current document
-	doc := &IAMPolicyDoc{
+	doc := &verify.IAMPolicyDoc{
 		Version: d.Get(names.AttrVersion).(string),
 	}
 
@@ -212,12 +213,12 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	if cfgStmts, hasCfgStmts := d.GetOk("statement"); hasCfgStmts {
 		var cfgStmtIntf = cfgStmts.([]interface{})
-		stmts := make([]*IAMPolicyStatement, len(cfgStmtIntf))
+		stmts := make([]*verify.IAMPolicyStatement, len(cfgStmtIntf))
 		sidMap := make(map[string]struct{})
 
 		for i, stmtI := range cfgStmtIntf {
 			cfgStmt := stmtI.(map[string]interface{})
-			stmt := &IAMPolicyStatement{
+			stmt := &verify.IAMPolicyStatement{
 				Effect: cfgStmt["effect"].(string),
 			}
 
@@ -296,7 +297,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 			if overrideJSON == nil {
 				continue
 			}
-			overrideDoc := &IAMPolicyDoc{}
+			overrideDoc := &verify.IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
 				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
 			}
@@ -349,12 +350,12 @@ func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (
 	}
 }
 
-func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (IAMPolicyStatementConditionSet, error) {
-	out := make([]IAMPolicyStatementCondition, len(in))
+func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (verify.IAMPolicyStatementConditionSet, error) {
+	out := make([]verify.IAMPolicyStatementCondition, len(in))
 	for i, itemI := range in {
 		var err error
 		item := itemI.(map[string]interface{})
-		out[i] = IAMPolicyStatementCondition{
+		out[i] = verify.IAMPolicyStatementCondition{
 			Test:     item["test"].(string),
 			Variable: item["variable"].(string),
 		}
@@ -370,15 +371,15 @@ func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (I
 			out[i].Values = itemValues[0]
 		}
 	}
-	return IAMPolicyStatementConditionSet(out), nil
+	return verify.IAMPolicyStatementConditionSet(out), nil
 }
 
-func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (IAMPolicyStatementPrincipalSet, error) {
-	out := make([]IAMPolicyStatementPrincipal, len(in))
+func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (verify.IAMPolicyStatementPrincipalSet, error) {
+	out := make([]verify.IAMPolicyStatementPrincipal, len(in))
 	for i, itemI := range in {
 		var err error
 		item := itemI.(map[string]interface{})
-		out[i] = IAMPolicyStatementPrincipal{
+		out[i] = verify.IAMPolicyStatementPrincipal{
 			Type: item[names.AttrType].(string),
 		}
 		out[i].Identifiers, err = dataSourcePolicyDocumentReplaceVarsInList(
@@ -390,5 +391,5 @@ func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (I
 			return nil, fmt.Errorf("reading identifiers: %w", err)
 		}
 	}
-	return IAMPolicyStatementPrincipalSet(out), nil
+	return verify.IAMPolicyStatementPrincipalSet(out), nil
 }
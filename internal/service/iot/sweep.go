@@ -20,7 +20,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -29,12 +29,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
 		Name: "aws_iot_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -42,17 +42,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
 		Name: "aws_iot_role_alias",
 		F:    sweepRoleAliases,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
 		Name: "aws_iot_thing",
 		F:    sweepThings,
 		Dependencies: []string{
@@ -60,12 +60,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
 		Name: "aws_iot_thing_group",
 		F:    sweepThingGroups,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
 		Name: "aws_iot_thing_type",
 		F:    sweepThingTypes,
 		Dependencies: []string{
@@ -73,7 +73,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
 		Name: "aws_iot_topic_rule",
 		F:    sweepTopicRules,
 		Dependencies: []string{
@@ -81,12 +81,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
 		Name: "aws_iot_topic_rule_destination",
 		F:    sweepTopicRuleDestinations,
 	})
 
-	resource.AddTestSweepers("aws_iot_authorizer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_authorizer", &resource.Sweeper{
 		Name: "aws_iot_authorizer",
 		F:    sweepAuthorizers,
 		Dependencies: []string{
@@ -94,12 +94,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_domain_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_domain_configuration", &resource.Sweeper{
 		Name: "aws_iot_domain_configuration",
 		F:    sweepDomainConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_iot_ca_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_ca_certificate", &resource.Sweeper{
 		Name: "aws_iot_ca_certificate",
 		F:    sweepCACertificates,
 		Dependencies: []string{
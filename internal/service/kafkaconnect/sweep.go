@@ -15,12 +15,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_mskconnect_connector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mskconnect_connector", &resource.Sweeper{
 		Name: "aws_mskconnect_connector",
 		F:    sweepConnectors,
 	})
 
-	resource.AddTestSweepers("aws_mskconnect_custom_plugin", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mskconnect_custom_plugin", &resource.Sweeper{
 		Name: "aws_mskconnect_custom_plugin",
 		F:    sweepCustomPlugins,
 		Dependencies: []string{
@@ -28,7 +28,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_mskconnect_worker_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mskconnect_worker_configuration", &resource.Sweeper{
 		Name: "aws_mskconnect_worker_configuration",
 		F:    sweepWorkerConfigurations,
 		Dependencies: []string{
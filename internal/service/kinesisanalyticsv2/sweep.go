@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_awstypes.application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_awstypes.application", &resource.Sweeper{
 		Name: "aws_awstypes.application",
 		F:    sweepApplication,
 	})
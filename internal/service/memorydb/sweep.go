@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
 		Name: "aws_memorydb_acl",
 		F:    sweepACLs,
 		Dependencies: []string{
@@ -24,12 +24,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
 		Name: "aws_memorydb_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
 		Name: "aws_memorydb_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
 		Name: "aws_memorydb_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -45,7 +45,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
 		Name: "aws_memorydb_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -53,7 +53,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
 		Name: "aws_memorydb_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const (
+	// authTokenExpiration is the time for which an IAM database authentication token is valid.
+	authTokenExpiration = 15 * time.Minute
+	// emptyPayloadHash is the hex encoded SHA-256 hash of an empty payload.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// buildAuthToken returns an IAM database authentication token for the specified DB user
+// on the DB instance or cluster endpoint ("host:port") in the specified Region.
+// The token is a SigV4-presigned "rds-db:connect" request without the URL scheme.
+// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.Connecting.html.
+func buildAuthToken(ctx context.Context, endpoint, region, dbUser string, credentials aws.Credentials, signingTime time.Time) (string, error) {
+	// The scheme is only required to build the request and is removed from the token.
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("Action", "connect")
+	query.Set("DBUser", dbUser)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(authTokenExpiration.Seconds())))
+	request.URL.RawQuery = query.Encode()
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, "rds-db", region, signingTime)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(signedURI, "https://"), nil
+}
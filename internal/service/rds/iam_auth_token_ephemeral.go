@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameIAMAuthToken = "Ephemeral IAM Auth Token"
+)
+
+// @EphemeralResource(aws_rds_iam_auth_token, name="IAM Auth Token")
+func newEphemeralIAMAuthToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralIAMAuthToken{}, nil
+}
+
+type ephemeralIAMAuthToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralIAMAuthToken) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_rds_iam_auth_token"
+}
+
+func (e *ephemeralIAMAuthToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrEndpoint: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPort: schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralIAMAuthToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epIAMAuthTokenData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Region.IsNull() {
+		data.Region = types.StringValue(e.Meta().Region(ctx))
+	}
+
+	endpoint := net.JoinHostPort(data.Endpoint.ValueString(), strconv.Itoa(int(data.Port.ValueInt32())))
+	credentialsProvider := e.Meta().CredentialsProvider(ctx)
+	if credentialsProvider == nil {
+		err := errors.New("no AWS credentials configured")
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionOpening, ERNameIAMAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionOpening, ERNameIAMAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	token, err := buildAuthToken(ctx, endpoint, data.Region.ValueString(), data.UserName.ValueString(), credentials, time.Now())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionOpening, ERNameIAMAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epIAMAuthTokenData struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Port     types.Int32  `tfsdk:"port"`
+	Region   types.String `tfsdk:"region"`
+	Token    types.String `tfsdk:"token"`
+	UserName types.String `tfsdk:"user_name"`
+}
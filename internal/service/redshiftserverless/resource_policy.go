@@ -19,7 +19,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -111,11 +110,11 @@ func resourceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, met
 
 	doc.Statement.Resources = nil
 
-	policyDoc := tfiam.IAMPolicyDoc{}
+	policyDoc := verify.IAMPolicyDoc{}
 
 	policyDoc.Id = doc.Id
 	policyDoc.Version = doc.Version
-	policyDoc.Statements = []*tfiam.IAMPolicyStatement{doc.Statement}
+	policyDoc.Statements = []*verify.IAMPolicyStatement{doc.Statement}
 
 	formattedPolicy, err := json.Marshal(policyDoc)
 	if err != nil {
@@ -185,7 +184,7 @@ func findResourcePolicyByARN(ctx context.Context, conn *redshiftserverless.Clien
 }
 
 type resourcePolicyDoc struct {
-	Version   string                    `json:",omitempty"`
-	Id        string                    `json:",omitempty"`
-	Statement *tfiam.IAMPolicyStatement `json:"Statement,omitempty"`
+	Version   string                     `json:",omitempty"`
+	Id        string                     `json:",omitempty"`
+	Statement *verify.IAMPolicyStatement `json:"Statement,omitempty"`
 }
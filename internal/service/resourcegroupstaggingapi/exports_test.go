@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+// Exports for use in tests only.
+var (
+	CommonTagValue         = commonTagValue
+	FindTagsByResourceARNs = findTagsByResourceARNs
+)
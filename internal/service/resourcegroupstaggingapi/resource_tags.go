@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_GetResources.html.
+	getResourcesMaxARNs = 100
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_TagResources.html.
+	tagResourcesMaxARNs = 20
+	tagResourcesMaxTags = 50
+)
+
+// @FrameworkResource("aws_resource_tags", name="Resource Tags")
+func newResourceTagsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTagsResource{}
+
+	return r, nil
+}
+
+type resourceTagsResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*resourceTagsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_resource_tags"
+}
+
+func (r *resourceTagsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"resource_arns": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Required:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTagsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs)
+	tags := r.managedTags(ctx, data.Tags)
+
+	if err := tagResources(ctx, conn, arns, tags); err != nil {
+		response.Diagnostics.AddError("creating Resource Tags", err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(sdkid.UniqueId())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTagsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs)
+	resourceTags, err := findTagsByResourceARNs(ctx, conn, arns)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Tags (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Resources that no longer exist, or that have had all their tags removed, are not returned.
+	// Remove them from state so that they are tagged again, or an error reported, on the next apply.
+	if missingARNs := slices.DeleteFunc(slices.Clone(arns), func(arn string) bool {
+		_, ok := resourceTags[arn]
+		return ok
+	}); len(missingARNs) > 0 {
+		response.Diagnostics.AddWarning(
+			fmt.Sprintf("Resource Tags (%s) resources not found", data.ID.ValueString()),
+			fmt.Sprintf("The following resources do not exist or have no tags and have been removed from state: %s", strings.Join(missingARNs, ", ")),
+		)
+
+		arns = slices.DeleteFunc(arns, func(arn string) bool {
+			return slices.Contains(missingARNs, arn)
+		})
+
+		if len(arns) == 0 {
+			response.State.RemoveResource(ctx)
+
+			return
+		}
+
+		elements := slices.DeleteFunc(data.ResourceARNs.Elements(), func(v attr.Value) bool {
+			arn, ok := v.(fwtypes.ARN)
+			return ok && slices.Contains(missingARNs, arn.ValueString())
+		})
+		data.ResourceARNs = fwtypes.NewSetValueOfMust[fwtypes.ARN](ctx, elements)
+	}
+
+	ignoreConfig := r.Meta().IgnoreTagsConfig(ctx)
+	tags := make(map[string]string)
+	for key, value := range tftags.New(ctx, data.Tags).Map() {
+		// Ignored tags are not managed, so keep their configured values.
+		if len(tftags.New(ctx, map[string]string{key: value}).IgnoreConfig(ignoreConfig)) == 0 {
+			tags[key] = value
+			continue
+		}
+
+		// A tag is only reported if all resources have the same value.
+		// Otherwise it is omitted from state and the tag is reapplied to all resources.
+		if v, ok := commonTagValue(arns, resourceTags, key); ok {
+			tags[key] = v
+		}
+	}
+
+	data.Tags = tftags.FlattenStringValueMap(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan verifies that the resources are in the provider's Region, as the Resource Groups Tagging API is regional.
+func (r *resourceTagsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy plan.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var resourceARNs fwtypes.SetOfARN
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("resource_arns"), &resourceARNs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if resourceARNs.IsNull() || resourceARNs.IsUnknown() {
+		return
+	}
+
+	region := r.Meta().Region(ctx)
+	for _, v := range resourceARNs.Elements() {
+		v, ok := v.(fwtypes.ARN)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		// Global resources' ARNs have no Region.
+		if arn := v.ValueARN(); arn.Region != "" && arn.Region != region {
+			response.Diagnostics.AddAttributeError(
+				path.Root("resource_arns"),
+				"Invalid Resource ARN",
+				fmt.Sprintf("resource (%s) is not in the provider's Region (%s)", v.ValueString(), region),
+			)
+		}
+	}
+}
+
+func (r *resourceTagsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceTagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	oldARNs := fwflex.ExpandFrameworkStringValueSet(ctx, old.ResourceARNs)
+	newARNs := fwflex.ExpandFrameworkStringValueSet(ctx, new.ResourceARNs)
+	oldTags := r.managedTags(ctx, old.Tags)
+	newTags := r.managedTags(ctx, new.Tags)
+
+	// Remove all managed tags from resources no longer in the set.
+	if removedARNs := oldARNs.Difference(newARNs); len(removedARNs) > 0 {
+		if err := untagResources(ctx, conn, removedARNs, oldTags.Keys()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Tags (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// Remove tags no longer managed from resources that remain in the set.
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		keptARNs := slices.DeleteFunc(slices.Clone(newARNs), func(arn string) bool {
+			return !slices.Contains(oldARNs, arn)
+		})
+
+		if err := untagResources(ctx, conn, keptARNs, removedTags.Keys()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Tags (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if err := tagResources(ctx, conn, newARNs, newTags); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Resource Tags (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceTagsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	arns := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs)
+	tags := r.managedTags(ctx, data.Tags)
+
+	if err := untagResources(ctx, conn, arns, tags.Keys()); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Tags (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// managedTags returns the configured tags that are not ignored by the provider's ignore_tags configuration.
+func (r *resourceTagsResource) managedTags(ctx context.Context, tags tftags.Map) tftags.KeyValueTags {
+	return tftags.New(ctx, tags).IgnoreConfig(r.Meta().IgnoreTagsConfig(ctx))
+}
+
+type resourceTagsResourceModel struct {
+	ID           types.String     `tfsdk:"id"`
+	ResourceARNs fwtypes.SetOfARN `tfsdk:"resource_arns"`
+	Tags         tftags.Map       `tfsdk:"tags"`
+}
+
+// commonTagValue returns the value of the tag with the specified key if all the specified resources have the same value.
+func commonTagValue(arns []string, resourceTags map[string]tftags.KeyValueTags, key string) (string, bool) {
+	var value *string
+
+	for _, arn := range arns {
+		v := resourceTags[arn].KeyValue(key)
+		if v == nil || (value != nil && aws.ToString(v) != aws.ToString(value)) {
+			return "", false
+		}
+		value = v
+	}
+
+	return aws.ToString(value), value != nil
+}
+
+// findTagsByResourceARNs returns the tags of the specified resources, keyed by ARN.
+// Resources that have no tags or that do not exist are not returned.
+func findTagsByResourceARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string) (map[string]tftags.KeyValueTags, error) {
+	output := make(map[string]tftags.KeyValueTags)
+
+	for chunk := range slices.Chunk(arns, getResourcesMaxARNs) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: chunk,
+		}
+
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				output[aws.ToString(v.ResourceARN)] = KeyValueTags(ctx, v.Tags)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// tagResources adds or updates the specified tags on the specified resources.
+// Failures for individual resources are returned as a single error.
+func tagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string, tags tftags.KeyValueTags) error {
+	var errs []error
+
+	for _, tagsChunk := range tags.Chunks(tagResourcesMaxTags) {
+		for arnsChunk := range slices.Chunk(arns, tagResourcesMaxARNs) {
+			input := &resourcegroupstaggingapi.TagResourcesInput{
+				ResourceARNList: arnsChunk,
+				Tags:            tagsChunk.Map(),
+			}
+
+			output, err := conn.TagResources(ctx, input)
+
+			if err != nil {
+				return fmt.Errorf("tagging resources: %w", err)
+			}
+
+			errs = append(errs, failedResourcesError("tagging", output.FailedResourcesMap)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// untagResources removes the tags with the specified keys from the specified resources.
+// Failures for individual resources are returned as a single error.
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, arns []string, keys []string) error {
+	var errs []error
+
+	for keysChunk := range slices.Chunk(keys, tagResourcesMaxTags) {
+		for arnsChunk := range slices.Chunk(arns, tagResourcesMaxARNs) {
+			input := &resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: arnsChunk,
+				TagKeys:         keysChunk,
+			}
+
+			output, err := conn.UntagResources(ctx, input)
+
+			if err != nil {
+				return fmt.Errorf("untagging resources: %w", err)
+			}
+
+			errs = append(errs, failedResourcesError("untagging", output.FailedResourcesMap)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// failedResourcesError returns an error for each resource that could not be tagged or untagged, sorted by ARN.
+func failedResourcesError(action string, failedResources map[string]awstypes.FailureInfo) []error {
+	var errs []error
+
+	for _, arn := range slices.Sorted(maps.Keys(failedResources)) {
+		v := failedResources[arn]
+		errs = append(errs, fmt.Errorf("%s resource (%s): %s: %s", action, arn, v.ErrorCode, strings.TrimSpace(aws.ToString(v.ErrorMessage))))
+	}
+
+	return errs
+}
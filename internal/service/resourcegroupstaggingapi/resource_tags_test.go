@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCommonTagValue(t *testing.T) {
+	t.Parallel()
+
+	arns := []string{"arn:aws:sqs:us-west-2:123456789012:a", "arn:aws:sqs:us-west-2:123456789012:b"} //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		resourceTags  map[string]tftags.KeyValueTags
+		expectedValue string
+		expectedOK    bool
+	}{
+		"no resources": {
+			resourceTags: map[string]tftags.KeyValueTags{},
+		},
+		"same value": {
+			resourceTags: map[string]tftags.KeyValueTags{
+				arns[0]: tftags.New(context.Background(), map[string]string{acctest.CtKey1: acctest.CtValue1}),
+				arns[1]: tftags.New(context.Background(), map[string]string{acctest.CtKey1: acctest.CtValue1, acctest.CtKey2: acctest.CtValue2}),
+			},
+			expectedValue: acctest.CtValue1,
+			expectedOK:    true,
+		},
+		"empty value": {
+			resourceTags: map[string]tftags.KeyValueTags{
+				arns[0]: tftags.New(context.Background(), map[string]string{acctest.CtKey1: ""}),
+				arns[1]: tftags.New(context.Background(), map[string]string{acctest.CtKey1: ""}),
+			},
+			expectedOK: true,
+		},
+		"different values": {
+			resourceTags: map[string]tftags.KeyValueTags{
+				arns[0]: tftags.New(context.Background(), map[string]string{acctest.CtKey1: acctest.CtValue1}),
+				arns[1]: tftags.New(context.Background(), map[string]string{acctest.CtKey1: acctest.CtValue1Updated}),
+			},
+		},
+		"missing tag": {
+			resourceTags: map[string]tftags.KeyValueTags{
+				arns[0]: tftags.New(context.Background(), map[string]string{acctest.CtKey1: acctest.CtValue1}),
+				arns[1]: tftags.New(context.Background(), map[string]string{acctest.CtKey2: acctest.CtValue1}),
+			},
+		},
+		"missing resource": {
+			resourceTags: map[string]tftags.KeyValueTags{
+				arns[0]: tftags.New(context.Background(), map[string]string{acctest.CtKey1: acctest.CtValue1}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, ok := tfresourcegroupstaggingapi.CommonTagValue(arns, testCase.resourceTags, acctest.CtKey1)
+
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := value, testCase.expectedValue; got != want {
+				t.Errorf("value = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resource_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckResourceTagsNotExist(ctx, "aws_sqs_queue.test.0", acctest.CtKey1, acctest.CtKey2),
+			testAccCheckResourceTagsNotExist(ctx, "aws_sqs_queue.test.1", acctest.CtKey1, acctest.CtKey2),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceTagsExist(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_sqs_queue.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_sqs_queue.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				// Destroy only the tags; the tagged resources remain.
+				Config: testAccResourceTagsConfig_base(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceTagsNotExist(ctx, "aws_sqs_queue.test.0", acctest.CtKey1, acctest.CtKey2),
+					testAccCheckResourceTagsNotExist(ctx, "aws_sqs_queue.test.1", acctest.CtKey1, acctest.CtKey2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resource_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceTagsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+				),
+			},
+			{
+				Config: testAccResourceTagsConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceTagsExist(ctx, resourceName),
+					testAccCheckResourceTagsNotExist(ctx, "aws_sqs_queue.test.1", acctest.CtKey1, acctest.CtKey2),
+					testAccCheckResourceTagsNotExist(ctx, "aws_sqs_queue.test.0", acctest.CtKey2),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_sqs_queue.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_sqs_queue.test.2", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, "tags.key3", "value3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_otherRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceTagsConfig_otherRegion(acctest.AlternateRegion()),
+				ExpectError: regexache.MustCompile(`not in the provider's Region`),
+			},
+		},
+	})
+}
+
+// testAccCheckResourceTagsExist verifies that all the resources have all the tags.
+func testAccCheckResourceTagsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		arns, tags := testAccResourceTagsFromState(rs)
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		output, err := tfresourcegroupstaggingapi.FindTagsByResourceARNs(ctx, conn, arns)
+
+		if err != nil {
+			return err
+		}
+
+		for _, arn := range arns {
+			for key, value := range tags {
+				if v, ok := tfresourcegroupstaggingapi.CommonTagValue([]string{arn}, output, key); !ok || v != value {
+					return fmt.Errorf("Resource Tags (%s) resource (%s) tag (%s) = %q, want %q", rs.Primary.ID, arn, key, v, value)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckResourceTagsNotExist verifies that the specified resource does not have any of the specified tags.
+func testAccCheckResourceTagsNotExist(ctx context.Context, n string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		arn := rs.Primary.Attributes[names.AttrARN]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		output, err := tfresourcegroupstaggingapi.FindTagsByResourceARNs(ctx, conn, []string{arn})
+
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if _, ok := tfresourcegroupstaggingapi.CommonTagValue([]string{arn}, output, key); ok {
+				return fmt.Errorf("resource (%s) tag (%s) still exists", arn, key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceTagsFromState(rs *terraform.ResourceState) ([]string, map[string]string) {
+	var arns []string
+	tags := make(map[string]string)
+
+	for k, v := range rs.Primary.Attributes {
+		if k == "resource_arns.#" || k == acctest.CtTagsPercent {
+			continue
+		}
+
+		if _, ok := strings.CutPrefix(k, "resource_arns."); ok {
+			arns = append(arns, v)
+		} else if key, ok := strings.CutPrefix(k, "tags."); ok {
+			tags[key] = v
+		}
+	}
+
+	return arns, tags
+}
+
+func testAccResourceTagsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  count = 3
+
+  name = "%[1]s-${count.index}"
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+`, rName)
+}
+
+func testAccResourceTagsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResourceTagsConfig_base(rName), `
+resource "aws_resource_tags" "test" {
+  resource_arns = [aws_sqs_queue.test[0].arn, aws_sqs_queue.test[1].arn]
+
+  tags = {
+    key1 = "value1"
+    key2 = "value2"
+  }
+}
+`)
+}
+
+func testAccResourceTagsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccResourceTagsConfig_base(rName), `
+resource "aws_resource_tags" "test" {
+  resource_arns = [aws_sqs_queue.test[0].arn, aws_sqs_queue.test[2].arn]
+
+  tags = {
+    key1 = "value1updated"
+    key3 = "value3"
+  }
+}
+`)
+}
+
+func testAccResourceTagsConfig_otherRegion(region string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_resource_tags" "test" {
+  resource_arns = ["arn:${data.aws_partition.current.partition}:sqs:%[1]s:${data.aws_caller_identity.current.account_id}:test"]
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, region)
+}
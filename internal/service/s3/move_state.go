@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// SDKResourceStateMovers returns the moves from legacy resources to this service package's replacement resources.
+func (p *servicePackage) SDKResourceStateMovers(ctx context.Context) []*itypes.ServicePackageSDKResourceStateMover {
+	return []*itypes.ServicePackageSDKResourceStateMover{
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_accelerate_configuration",
+			StateMover:     moveStateFromBucket(bucketAccelerateConfigurationFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_acl",
+			StateMover:     moveStateFromBucket(bucketACLFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_cors_configuration",
+			StateMover:     moveStateFromBucket(bucketCORSConfigurationFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_lifecycle_configuration",
+			StateMover:     moveStateFromBucket(bucketLifecycleConfigurationFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_logging",
+			StateMover:     moveStateFromBucket(bucketLoggingFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_object_lock_configuration",
+			StateMover:     moveStateFromBucket(bucketObjectLockConfigurationFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_policy",
+			StateMover:     moveStateFromBucket(bucketPolicyFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_replication_configuration",
+			StateMover:     moveStateFromBucket(bucketReplicationConfigurationFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_request_payment_configuration",
+			StateMover:     moveStateFromBucket(bucketRequestPaymentConfigurationFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_server_side_encryption_configuration",
+			StateMover:     moveStateFromBucket(bucketServerSideEncryptionConfigurationFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_versioning",
+			StateMover:     moveStateFromBucket(bucketVersioningFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket",
+			TargetTypeName: "aws_s3_bucket_website_configuration",
+			StateMover:     moveStateFromBucket(bucketWebsiteConfigurationFromBucket),
+		},
+		{
+			SourceTypeName: "aws_s3_bucket_object",
+			TargetTypeName: "aws_s3_object",
+			StateMover:     moveStateFromBucketObject,
+		},
+	}
+}
+
+// moveStateFromBucket returns a state mover from an `aws_s3_bucket` resource to a bucket configuration resource.
+// f returns the configuration resource's attributes from the bucket's deprecated inline configuration.
+// Attributes that are not set are populated on the next refresh.
+func moveStateFromBucket(f func(map[string]any) (map[string]any, error)) func(context.Context, map[string]any) (map[string]any, error) {
+	return func(_ context.Context, source map[string]any) (map[string]any, error) {
+		bucket, _ := source[names.AttrBucket].(string)
+		if bucket == "" {
+			return nil, errors.New("bucket name not found")
+		}
+
+		target, err := f(source)
+		if err != nil {
+			return nil, fmt.Errorf("S3 Bucket (%s): %w", bucket, err)
+		}
+
+		target[names.AttrBucket] = bucket
+		if _, ok := target[names.AttrID]; !ok {
+			target[names.AttrID] = CreateResourceID(bucket, "")
+		}
+
+		return target, nil
+	}
+}
+
+func bucketAccelerateConfigurationFromBucket(source map[string]any) (map[string]any, error) {
+	v, _ := source["acceleration_status"].(string)
+	if v == "" {
+		return nil, errors.New("no accelerate configuration")
+	}
+
+	return map[string]any{
+		names.AttrStatus: v,
+	}, nil
+}
+
+func bucketACLFromBucket(source map[string]any) (map[string]any, error) {
+	target := make(map[string]any)
+
+	// The canned ACL is part of the resource ID.
+	// Grants are populated on the next refresh.
+	if v, _ := source["acl"].(string); v != "" {
+		target["acl"] = v
+		target[names.AttrID] = BucketACLCreateResourceID(source[names.AttrBucket].(string), "", v)
+	}
+
+	return target, nil
+}
+
+func bucketCORSConfigurationFromBucket(source map[string]any) (map[string]any, error) {
+	rules, _ := source["cors_rule"].([]any)
+	if len(rules) == 0 {
+		return nil, errors.New("no CORS configuration")
+	}
+
+	return map[string]any{
+		"cors_rule": rules,
+	}, nil
+}
+
+func bucketLifecycleConfigurationFromBucket(source map[string]any) (map[string]any, error) {
+	// Lifecycle rules are populated on the next refresh.
+	if rules, _ := source["lifecycle_rule"].([]any); len(rules) == 0 {
+		return nil, errors.New("no lifecycle configuration")
+	}
+
+	return map[string]any{}, nil
+}
+
+func bucketLoggingFromBucket(source map[string]any) (map[string]any, error) {
+	tfMap := firstBlock(source["logging"])
+	if tfMap == nil {
+		return nil, errors.New("no logging configuration")
+	}
+
+	return map[string]any{
+		"target_bucket": tfMap["target_bucket"],
+		"target_prefix": tfMap["target_prefix"],
+	}, nil
+}
+
+func bucketObjectLockConfigurationFromBucket(source map[string]any) (map[string]any, error) {
+	tfMap := firstBlock(source["object_lock_configuration"])
+	if tfMap == nil {
+		return nil, errors.New("no object lock configuration")
+	}
+
+	return map[string]any{
+		"object_lock_enabled": string(types.ObjectLockEnabledEnabled),
+		names.AttrRule:        tfMap[names.AttrRule],
+	}, nil
+}
+
+func bucketPolicyFromBucket(source map[string]any) (map[string]any, error) {
+	v, _ := source[names.AttrPolicy].(string)
+	if v == "" {
+		return nil, errors.New("no bucket policy")
+	}
+
+	return map[string]any{
+		names.AttrPolicy: v,
+	}, nil
+}
+
+func bucketReplicationConfigurationFromBucket(source map[string]any) (map[string]any, error) {
+	// Replication rules are populated on the next refresh.
+	tfMap := firstBlock(source["replication_configuration"])
+	if tfMap == nil {
+		return nil, errors.New("no replication configuration")
+	}
+
+	return map[string]any{
+		names.AttrRole: tfMap[names.AttrRole],
+	}, nil
+}
+
+func bucketRequestPaymentConfigurationFromBucket(source map[string]any) (map[string]any, error) {
+	v, _ := source["request_payer"].(string)
+	if v == "" {
+		v = string(types.PayerBucketOwner)
+	}
+
+	return map[string]any{
+		"payer": v,
+	}, nil
+}
+
+func bucketServerSideEncryptionConfigurationFromBucket(source map[string]any) (map[string]any, error) {
+	tfMap := firstBlock(source["server_side_encryption_configuration"])
+	if tfMap == nil {
+		return nil, errors.New("no server-side encryption configuration")
+	}
+
+	return map[string]any{
+		names.AttrRule: tfMap[names.AttrRule],
+	}, nil
+}
+
+func bucketVersioningFromBucket(source map[string]any) (map[string]any, error) {
+	// Suspended versioning cannot be distinguished from versioning that has never been enabled.
+	// The status is corrected on the next refresh.
+	status, mfaDelete := bucketVersioningStatusDisabled, string(types.MFADeleteDisabled)
+	if tfMap := firstBlock(source["versioning"]); tfMap != nil {
+		if v, _ := tfMap[names.AttrEnabled].(bool); v {
+			status = string(types.BucketVersioningStatusEnabled)
+		}
+		if v, _ := tfMap["mfa_delete"].(bool); v {
+			mfaDelete = string(types.MFADeleteEnabled)
+		}
+	}
+
+	return map[string]any{
+		"versioning_configuration": []any{
+			map[string]any{
+				"mfa_delete":     mfaDelete,
+				names.AttrStatus: status,
+			},
+		},
+	}, nil
+}
+
+func bucketWebsiteConfigurationFromBucket(source map[string]any) (map[string]any, error) {
+	tfMap := firstBlock(source["website"])
+	if tfMap == nil {
+		return nil, errors.New("no website configuration")
+	}
+
+	target := map[string]any{
+		"website_domain":   source["website_domain"],
+		"website_endpoint": source["website_endpoint"],
+	}
+
+	if v, _ := tfMap["error_document"].(string); v != "" {
+		target["error_document"] = []any{map[string]any{names.AttrKey: v}}
+	}
+
+	if v, _ := tfMap["index_document"].(string); v != "" {
+		target["index_document"] = []any{map[string]any{"suffix": v}}
+	}
+
+	// The redirect host name may be prefixed by the protocol, e.g. "https://example.com".
+	if v, _ := tfMap["redirect_all_requests_to"].(string); v != "" {
+		redirect := map[string]any{"host_name": v}
+		if u, err := url.Parse(v); err == nil && u.Scheme != "" {
+			redirect["host_name"] = u.Host + u.Path
+			redirect[names.AttrProtocol] = u.Scheme
+		}
+		target["redirect_all_requests_to"] = []any{redirect}
+	}
+
+	if v, _ := tfMap["routing_rules"].(string); v != "" {
+		target["routing_rules"] = v
+	}
+
+	return target, nil
+}
+
+// moveStateFromBucketObject moves the state of an `aws_s3_bucket_object` resource to an `aws_s3_object` resource.
+// The resources' schemas and IDs are compatible.
+func moveStateFromBucketObject(_ context.Context, source map[string]any) (map[string]any, error) {
+	if v, _ := source[names.AttrKey].(string); v == "" {
+		return nil, errors.New("object key not found")
+	}
+
+	return maps.Clone(source), nil
+}
+
+// firstBlock returns the first element of a JSON-decoded list block, or nil if the list is empty.
+func firstBlock(v any) map[string]any {
+	if v, ok := v.([]any); ok && len(v) > 0 {
+		if v, ok := v[0].(map[string]any); ok {
+			return v
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSDKResourceStateMovers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stateMovers := make(map[string]func(context.Context, map[string]any) (map[string]any, error))
+	for _, v := range (&servicePackage{}).SDKResourceStateMovers(ctx) {
+		stateMovers[v.SourceTypeName+"->"+v.TargetTypeName] = v.StateMover
+	}
+
+	testCases := map[string]struct {
+		move      string
+		source    map[string]any
+		expected  map[string]any
+		wantError bool
+	}{
+		"accelerate configuration": {
+			move: "aws_s3_bucket->aws_s3_bucket_accelerate_configuration",
+			source: map[string]any{
+				"acceleration_status": "Enabled",
+				"bucket":              "example",
+				"id":                  "example",
+			},
+			expected: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"status": "Enabled",
+			},
+		},
+		"accelerate configuration not configured": {
+			move: "aws_s3_bucket->aws_s3_bucket_accelerate_configuration",
+			source: map[string]any{
+				"acceleration_status": "",
+				"bucket":              "example",
+				"id":                  "example",
+			},
+			wantError: true,
+		},
+		"ACL canned": {
+			move: "aws_s3_bucket->aws_s3_bucket_acl",
+			source: map[string]any{
+				"acl":    "private",
+				"bucket": "example",
+				"id":     "example",
+			},
+			expected: map[string]any{
+				"acl":    "private",
+				"bucket": "example",
+				"id":     "example,private",
+			},
+		},
+		"ACL grants": {
+			move: "aws_s3_bucket->aws_s3_bucket_acl",
+			source: map[string]any{
+				"acl":    nil,
+				"bucket": "example",
+				"grant":  []any{map[string]any{"permissions": []any{"FULL_CONTROL"}, "type": "CanonicalUser"}},
+				"id":     "example",
+			},
+			expected: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+			},
+		},
+		"CORS configuration": {
+			move: "aws_s3_bucket->aws_s3_bucket_cors_configuration",
+			source: map[string]any{
+				"bucket": "example",
+				"cors_rule": []any{
+					map[string]any{"allowed_methods": []any{"GET"}, "allowed_origins": []any{"*"}},
+				},
+				"id": "example",
+			},
+			expected: map[string]any{
+				"bucket": "example",
+				"cors_rule": []any{
+					map[string]any{"allowed_methods": []any{"GET"}, "allowed_origins": []any{"*"}},
+				},
+				"id": "example",
+			},
+		},
+		"lifecycle configuration": {
+			move: "aws_s3_bucket->aws_s3_bucket_lifecycle_configuration",
+			source: map[string]any{
+				"bucket":         "example",
+				"id":             "example",
+				"lifecycle_rule": []any{map[string]any{"enabled": true, "id": "rule1"}},
+			},
+			expected: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+			},
+		},
+		"logging": {
+			move: "aws_s3_bucket->aws_s3_bucket_logging",
+			source: map[string]any{
+				"bucket":  "example",
+				"id":      "example",
+				"logging": []any{map[string]any{"target_bucket": "logs", "target_prefix": "example/"}},
+			},
+			expected: map[string]any{
+				"bucket":        "example",
+				"id":            "example",
+				"target_bucket": "logs",
+				"target_prefix": "example/",
+			},
+		},
+		"logging not configured": {
+			move: "aws_s3_bucket->aws_s3_bucket_logging",
+			source: map[string]any{
+				"bucket":  "example",
+				"id":      "example",
+				"logging": []any{},
+			},
+			wantError: true,
+		},
+		"object lock configuration": {
+			move: "aws_s3_bucket->aws_s3_bucket_object_lock_configuration",
+			source: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"object_lock_configuration": []any{map[string]any{
+					"object_lock_enabled": "Enabled",
+					"rule":                []any{map[string]any{"default_retention": []any{map[string]any{"days": 5, "mode": "GOVERNANCE"}}}},
+				}},
+			},
+			expected: map[string]any{
+				"bucket":              "example",
+				"id":                  "example",
+				"object_lock_enabled": "Enabled",
+				"rule":                []any{map[string]any{"default_retention": []any{map[string]any{"days": 5, "mode": "GOVERNANCE"}}}},
+			},
+		},
+		"policy": {
+			move: "aws_s3_bucket->aws_s3_bucket_policy",
+			source: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"policy": `{"Version":"2012-10-17","Statement":[]}`,
+			},
+			expected: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"policy": `{"Version":"2012-10-17","Statement":[]}`,
+			},
+		},
+		"replication configuration": {
+			move: "aws_s3_bucket->aws_s3_bucket_replication_configuration",
+			source: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"replication_configuration": []any{map[string]any{
+					"role":  "arn:aws:iam::123456789012:role/replication", //lintignore:AWSAT005
+					"rules": []any{map[string]any{"status": "Enabled"}},
+				}},
+			},
+			expected: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"role":   "arn:aws:iam::123456789012:role/replication", //lintignore:AWSAT005
+			},
+		},
+		"request payment configuration": {
+			move: "aws_s3_bucket->aws_s3_bucket_request_payment_configuration",
+			source: map[string]any{
+				"bucket":        "example",
+				"id":            "example",
+				"request_payer": "Requester",
+			},
+			expected: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"payer":  "Requester",
+			},
+		},
+		"server-side encryption configuration": {
+			move: "aws_s3_bucket->aws_s3_bucket_server_side_encryption_configuration",
+			source: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"server_side_encryption_configuration": []any{map[string]any{
+					"rule": []any{map[string]any{
+						"apply_server_side_encryption_by_default": []any{map[string]any{"kms_master_key_id": "", "sse_algorithm": "AES256"}},
+						"bucket_key_enabled":                      false,
+					}},
+				}},
+			},
+			expected: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"rule": []any{map[string]any{
+					"apply_server_side_encryption_by_default": []any{map[string]any{"kms_master_key_id": "", "sse_algorithm": "AES256"}},
+					"bucket_key_enabled":                      false,
+				}},
+			},
+		},
+		"versioning enabled": {
+			move: "aws_s3_bucket->aws_s3_bucket_versioning",
+			source: map[string]any{
+				"bucket":     "example",
+				"id":         "example",
+				"versioning": []any{map[string]any{"enabled": true, "mfa_delete": false}},
+			},
+			expected: map[string]any{
+				"bucket":                   "example",
+				"id":                       "example",
+				"versioning_configuration": []any{map[string]any{"mfa_delete": "Disabled", "status": "Enabled"}},
+			},
+		},
+		"versioning disabled": {
+			move: "aws_s3_bucket->aws_s3_bucket_versioning",
+			source: map[string]any{
+				"bucket":     "example",
+				"id":         "example",
+				"versioning": []any{map[string]any{"enabled": false, "mfa_delete": false}},
+			},
+			expected: map[string]any{
+				"bucket":                   "example",
+				"id":                       "example",
+				"versioning_configuration": []any{map[string]any{"mfa_delete": "Disabled", "status": "Disabled"}},
+			},
+		},
+		"website configuration": {
+			move: "aws_s3_bucket->aws_s3_bucket_website_configuration",
+			source: map[string]any{
+				"bucket": "example",
+				"id":     "example",
+				"website": []any{map[string]any{
+					"error_document": "error.html",
+					"index_document": "index.html",
+					"routing_rules":  `[{"Redirect":{"ReplaceKeyPrefixWith":"documents/"}}]`,
+				}},
+				"website_domain":   "s3-website.example.com",
+				"website_endpoint": "example.s3-website.example.com",
+			},
+			expected: map[string]any{
+				"bucket":           "example",
+				"error_document":   []any{map[string]any{"key": "error.html"}},
+				"id":               "example",
+				"index_document":   []any{map[string]any{"suffix": "index.html"}},
+				"routing_rules":    `[{"Redirect":{"ReplaceKeyPrefixWith":"documents/"}}]`,
+				"website_domain":   "s3-website.example.com",
+				"website_endpoint": "example.s3-website.example.com",
+			},
+		},
+		"website configuration redirect": {
+			move: "aws_s3_bucket->aws_s3_bucket_website_configuration",
+			source: map[string]any{
+				"bucket":           "example",
+				"id":               "example",
+				"website":          []any{map[string]any{"redirect_all_requests_to": "https://example.com"}},
+				"website_domain":   "s3-website.example.com",
+				"website_endpoint": "example.s3-website.example.com",
+			},
+			expected: map[string]any{
+				"bucket":                   "example",
+				"id":                       "example",
+				"redirect_all_requests_to": []any{map[string]any{"host_name": "example.com", "protocol": "https"}},
+				"website_domain":           "s3-website.example.com",
+				"website_endpoint":         "example.s3-website.example.com",
+			},
+		},
+		"no bucket name": {
+			move: "aws_s3_bucket->aws_s3_bucket_policy",
+			source: map[string]any{
+				"policy": `{"Version":"2012-10-17","Statement":[]}`,
+			},
+			wantError: true,
+		},
+		"object": {
+			move: "aws_s3_bucket_object->aws_s3_object",
+			source: map[string]any{
+				"bucket":       "example",
+				"content_type": "text/plain",
+				"etag":         "d41d8cd98f00b204e9800998ecf8427e",
+				"id":           "path/to/object",
+				"key":          "path/to/object",
+				"tags":         map[string]any{"Name": "example"},
+			},
+			expected: map[string]any{
+				"bucket":       "example",
+				"content_type": "text/plain",
+				"etag":         "d41d8cd98f00b204e9800998ecf8427e",
+				"id":           "path/to/object",
+				"key":          "path/to/object",
+				"tags":         map[string]any{"Name": "example"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stateMover, ok := stateMovers[testCase.move]
+			if !ok {
+				t.Fatalf("no state mover for %s", testCase.move)
+			}
+
+			got, err := stateMover(ctx, testCase.source)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("StateMover() error = %v, want error = %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
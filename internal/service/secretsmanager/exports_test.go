@@ -10,6 +10,8 @@ var (
 	ResourceSecretRotation = resourceSecretRotation
 	ResourceSecretVersion  = resourceSecretVersion
 
+	DataSourceRandomPassword = dataSourceRandomPassword
+
 	FindSecretByID                = findSecretByID
 	FindSecretPolicyByID          = findSecretPolicyByID
 	FindSecretVersionByTwoPartKey = findSecretVersionByTwoPartKey
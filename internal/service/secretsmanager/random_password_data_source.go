@@ -58,6 +58,8 @@ func dataSourceRandomPassword() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		DeprecationMessage: `The aws_secretsmanager_random_password data source stores the generated password in the Terraform plan and state. Use the aws_secretsmanager_random_password ephemeral resource instead to avoid persisting the password.`,
 	}
 }
 
@@ -5,15 +5,37 @@ package secretsmanager_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestRandomPasswordDataSourceValidateWarning(t *testing.T) {
+	t.Parallel()
+
+	diags := tfsecretsmanager.DataSourceRandomPassword().Validate(terraformsdk.NewResourceConfigRaw(map[string]any{}))
+
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("got %d diagnostics, want %d", got, want)
+	}
+
+	if got, want := diags[0].Severity, diag.Warning; got != want {
+		t.Errorf("got severity %v, want %v", got, want)
+	}
+
+	if got, want := diags[0].Detail, "ephemeral resource"; !strings.Contains(got, want) {
+		t.Errorf("got detail %q, want it to contain %q", got, want)
+	}
+}
+
 func TestAccSecretsManagerRandomPasswordDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	datasourceName := "data.aws_secretsmanager_random_password.test"
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameRandomPassword = "Ephemeral Random Password"
+)
+
+// @EphemeralResource(aws_secretsmanager_random_password, name="Random Password")
+func newEphemeralRandomPassword(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralRandomPassword{}, nil
+}
+
+type ephemeralRandomPassword struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralRandomPassword) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_secretsmanager_random_password"
+}
+
+func (e *ephemeralRandomPassword) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"exclude_characters": schema.StringAttribute{
+				Optional: true,
+			},
+			"exclude_lowercase": schema.BoolAttribute{
+				Optional: true,
+			},
+			"exclude_numbers": schema.BoolAttribute{
+				Optional: true,
+			},
+			"exclude_punctuation": schema.BoolAttribute{
+				Optional: true,
+			},
+			"exclude_uppercase": schema.BoolAttribute{
+				Optional: true,
+			},
+			"include_space": schema.BoolAttribute{
+				Optional: true,
+			},
+			"password_length": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4096),
+				},
+			},
+			"random_password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"require_each_included_type": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralRandomPassword) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epRandomPasswordData
+	conn := e.Meta().SecretsManagerClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Terraform does not have the notion of planning for ephemeral resources,
+	// so default handlers are not implemented for them in the Terraform Plugin Framework.
+	//
+	// To align with the data source data.aws_secretsmanager_random_password,
+	// we default `password_length`.
+	if data.PasswordLength.IsNull() {
+		data.PasswordLength = types.Int64Value(32)
+	}
+
+	input := &secretsmanager.GetRandomPasswordInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetRandomPassword(ctx, input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecretsManager, create.ErrActionOpening, ERNameRandomPassword, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.RandomPassword = fwflex.StringToFramework(ctx, output.RandomPassword)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epRandomPasswordData struct {
+	ExcludeCharacters       types.String `tfsdk:"exclude_characters"`
+	ExcludeLowercase        types.Bool   `tfsdk:"exclude_lowercase"`
+	ExcludeNumbers          types.Bool   `tfsdk:"exclude_numbers"`
+	ExcludePunctuation      types.Bool   `tfsdk:"exclude_punctuation"`
+	ExcludeUppercase        types.Bool   `tfsdk:"exclude_uppercase"`
+	IncludeSpace            types.Bool   `tfsdk:"include_space"`
+	PasswordLength          types.Int64  `tfsdk:"password_length"`
+	RandomPassword          types.String `tfsdk:"random_password"`
+	RequireEachIncludedType types.Bool   `tfsdk:"require_each_included_type"`
+}
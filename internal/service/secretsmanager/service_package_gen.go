@@ -16,6 +16,10 @@ type servicePackage struct{}
 
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
 	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory: newEphemeralRandomPassword,
+			Name:    "Random Password",
+		},
 		{
 			Factory: newEphemeralSecretVersion,
 			Name:    "Secret Version",
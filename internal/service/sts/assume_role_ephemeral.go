@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRole = "Ephemeral Assume Role"
+)
+
+// @EphemeralResource(aws_sts_assume_role, name="Assume Role")
+func newEphemeralAssumeRole(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAssumeRole{}, nil
+}
+
+type ephemeralAssumeRole struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAssumeRole) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sts_assume_role"
+}
+
+func (e *ephemeralAssumeRole) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"assumed_role_arn": schema.StringAttribute{
+			Computed: true,
+		},
+		"assumed_role_id": schema.StringAttribute{
+			Computed: true,
+		},
+		names.AttrDuration: schema.StringAttribute{
+			CustomType: fwtypes.DurationType,
+			Optional:   true,
+		},
+		names.AttrExternalID: schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 1224),
+			},
+		},
+		names.AttrPolicy: schema.StringAttribute{
+			CustomType: fwtypes.IAMPolicyType,
+			Optional:   true,
+		},
+		"policy_arns": schema.SetAttribute{
+			CustomType: fwtypes.SetOfARNType,
+			Optional:   true,
+		},
+		names.AttrRoleARN: schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Required:   true,
+		},
+		"session_name": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 64),
+			},
+		},
+		"source_identity": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 64),
+			},
+		},
+		names.AttrTags: schema.MapAttribute{
+			CustomType: fwtypes.MapOfStringType,
+			Optional:   true,
+		},
+		"transitive_tag_keys": schema.SetAttribute{
+			CustomType: fwtypes.SetOfStringType,
+			Optional:   true,
+		},
+	}
+	maps.Copy(attributes, credentialsAttributes())
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *ephemeralAssumeRole) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAssumeRoleData
+	conn := e.Meta().STSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	roleARN := data.RoleARN.ValueString()
+	input := &sts.AssumeRoleInput{
+		ExternalId:      fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:          fwflex.StringFromFramework(ctx, data.Policy),
+		RoleArn:         aws.String(roleARN),
+		RoleSessionName: fwflex.StringFromFramework(ctx, data.SessionName),
+		SourceIdentity:  fwflex.StringFromFramework(ctx, data.SourceIdentity),
+	}
+
+	if v := data.Duration.ValueDuration(); v > 0 {
+		input.DurationSeconds = aws.Int32(int32(v.Seconds()))
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	tags := fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags)
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		input.Tags = append(input.Tags, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	input.TransitiveTagKeys = fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys)
+
+	output, err := conn.AssumeRole(ctx, input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionOpening, ERNameAssumeRole, roleARN, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if v := output.AssumedRoleUser; v != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, v.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, v.AssumedRoleId)
+	}
+	data.credentialsModel.flatten(ctx, output.Credentials)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAssumeRoleData struct {
+	credentialsModel
+	AssumedRoleARN    types.String        `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String        `tfsdk:"assumed_role_id"`
+	Duration          fwtypes.Duration    `tfsdk:"duration"`
+	ExternalID        types.String        `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy   `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN    `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN         `tfsdk:"role_arn"`
+	SessionName       types.String        `tfsdk:"session_name"`
+	SourceIdentity    types.String        `tfsdk:"source_identity"`
+	Tags              fwtypes.MapOfString `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.SetOfString `tfsdk:"transitive_tag_keys"`
+}
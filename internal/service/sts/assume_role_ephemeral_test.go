@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSTSAssumeRoleEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralResourceConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("assumed_role_arn"), knownvalue.StringRegexp(regexache.MustCompile(fmt.Sprintf(`:assumed-role/%s/%s$`, rName, rName)))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("assumed_role_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_access_key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("session_token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSTSAssumeRoleEphemeral_sessionTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralResourceConfig_sessionTags(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrDuration), knownvalue.StringExact("30m")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("source_identity"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						"Project": knownvalue.StringExact(rName),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccAssumeRoleEphemeralResourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "sts:AssumeRole",
+        "sts:SetSourceIdentity",
+        "sts:TagSession",
+      ]
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccAssumeRoleEphemeralResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssumeRoleEphemeralResourceConfig_base(rName),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role.test"),
+		fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn     = aws_iam_role.test.arn
+  session_name = %[1]q
+}
+`, rName))
+}
+
+func testAccAssumeRoleEphemeralResourceConfig_sessionTags(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssumeRoleEphemeralResourceConfig_base(rName),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role.test"),
+		fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn        = aws_iam_role.test.arn
+  session_name    = %[1]q
+  duration        = "30m"
+  source_identity = %[1]q
+  policy_arns     = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"]
+
+  tags = {
+    Project = %[1]q
+  }
+
+  transitive_tag_keys = ["Project"]
+}
+`, rName))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// credentialsAttributes returns the schema attributes of temporary security credentials
+// returned by ephemeral resources.
+func credentialsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"access_key_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"expiration": schema.StringAttribute{
+			CustomType: timetypes.RFC3339Type{},
+			Computed:   true,
+		},
+		"secret_access_key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+		"session_token": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+type credentialsModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
+
+func (m *credentialsModel) flatten(ctx context.Context, apiObject *awstypes.Credentials) {
+	if apiObject == nil {
+		return
+	}
+
+	m.AccessKeyID = fwflex.StringToFramework(ctx, apiObject.AccessKeyId)
+	m.Expiration = fwflex.TimeToFramework(ctx, apiObject.Expiration)
+	m.SecretAccessKey = fwflex.StringToFramework(ctx, apiObject.SecretAccessKey)
+	m.SessionToken = fwflex.StringToFramework(ctx, apiObject.SessionToken)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"maps"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameSessionToken = "Ephemeral Session Token"
+)
+
+// @EphemeralResource(aws_sts_session_token, name="Session Token")
+func newEphemeralSessionToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSessionToken{}, nil
+}
+
+type ephemeralSessionToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralSessionToken) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sts_session_token"
+}
+
+func (e *ephemeralSessionToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		names.AttrDuration: schema.StringAttribute{
+			CustomType: fwtypes.DurationType,
+			Optional:   true,
+		},
+		"serial_number": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(9, 256),
+				stringvalidator.AlsoRequires(path.MatchRoot("token_code")),
+			},
+		},
+		"token_code": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(6, 6),
+				stringvalidator.AlsoRequires(path.MatchRoot("serial_number")),
+			},
+		},
+	}
+	maps.Copy(attributes, credentialsAttributes())
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *ephemeralSessionToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epSessionTokenData
+	conn := e.Meta().STSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &sts.GetSessionTokenInput{
+		SerialNumber: fwflex.StringFromFramework(ctx, data.SerialNumber),
+		TokenCode:    fwflex.StringFromFramework(ctx, data.TokenCode),
+	}
+
+	if v := data.Duration.ValueDuration(); v > 0 {
+		input.DurationSeconds = aws.Int32(int32(v.Seconds()))
+	}
+
+	output, err := conn.GetSessionToken(ctx, input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionOpening, ERNameSessionToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.credentialsModel.flatten(ctx, output.Credentials)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epSessionTokenData struct {
+	credentialsModel
+	Duration     fwtypes.Duration `tfsdk:"duration"`
+	SerialNumber types.String     `tfsdk:"serial_number"`
+	TokenCode    types.String     `tfsdk:"token_code"`
+}
@@ -5,10 +5,12 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,7 +21,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/description"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -49,30 +54,14 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	resource, state, err := sr.newResource(ctx)
 
 	if err != nil {
 		return err
 	}
 
-	metadata := resourceMetadata(ctx, resource)
-	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
-
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
-
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
-
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
-	}
-
+	ctx = tflog.SetField(ctx, "resource_type", resourceMetadata(ctx, resource).TypeName)
 	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
@@ -118,6 +107,127 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// creationTimeAttributes are the names of attributes commonly holding a resource's creation time.
+var creationTimeAttributes = []string{
+	names.AttrCreateTime,
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+}
+
+// Describe returns a description of the resource to be swept.
+// If refresh is true, the resource is first read so that its tags and creation time are available.
+func (sr *sweepResource) Describe(ctx context.Context, refresh bool) (*description.Description, error) {
+	desc := &description.Description{}
+
+	for _, attr := range sr.attributes {
+		v, ok := attr.value.(string)
+		if !ok {
+			continue
+		}
+
+		switch attr.path {
+		case names.AttrID:
+			desc.ID = v
+		case names.AttrName:
+			desc.Name = v
+		}
+	}
+
+	// Some resources are identified by other attributes, e.g. `arn`.
+	if desc.ID == "" && len(sr.attributes) > 0 {
+		desc.ID = fmt.Sprint(sr.attributes[0].value)
+	}
+
+	if !refresh {
+		return desc, nil
+	}
+
+	resource, state, err := sr.newResource(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Resources using transparent tagging return their tags in Context.
+	ctx = tftags.NewContext(ctx, nil, nil)
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	if response.State.Raw.IsNull() {
+		return &description.Description{}, nil
+	}
+
+	if desc.Name == "" {
+		var name *string
+		if d := response.State.GetAttribute(ctx, path.Root(names.AttrName), &name); !d.HasError() {
+			desc.Name = aws.ToString(name)
+		}
+	}
+
+	for _, attr := range []string{names.AttrTagsAll, names.AttrTags} {
+		var tags map[string]string
+		if d := response.State.GetAttribute(ctx, path.Root(attr), &tags); !d.HasError() && len(tags) > 0 {
+			desc.Tags = tags
+			break
+		}
+	}
+	if desc.Tags == nil {
+		if inContext, ok := tftags.FromContext(ctx); ok {
+			if tags, err := inContext.TagsOut.Unwrap(); err == nil {
+				desc.Tags = tags.Map()
+			}
+		}
+	}
+
+	for _, attr := range creationTimeAttributes {
+		var v *string
+		if d := response.State.GetAttribute(ctx, path.Root(attr), &v); !d.HasError() && v != nil {
+			if t, err := time.Parse(time.RFC3339, *v); err == nil {
+				desc.CreatedAt = &t
+				break
+			}
+		}
+	}
+
+	return desc, nil
+}
+
+// newResource returns the configured resource and its state, populated from the resource's attributes.
+func (sr *sweepResource) newResource(ctx context.Context) (fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return nil, tfsdk.State{}, err
+	}
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
+		}
+	}
+
+	return resource, state, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
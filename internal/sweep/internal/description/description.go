@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package description
+
+import (
+	"time"
+)
+
+// Description describes a resource to be swept.
+type Description struct {
+	ID   string            `json:"id"`
+	Name string            `json:"name,omitempty"`
+	Tags map[string]string `json:"tags,omitempty"`
+	// CreatedAt is the resource's creation time, or nil if unknown.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// DisplayName returns the resource's name, or its ID if the resource has no name.
+func (d *Description) DisplayName() string {
+	if d.Name != "" {
+		return d.Name
+	}
+
+	return d.ID
+}
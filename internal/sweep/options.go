@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/description"
+)
+
+// Description describes a resource to be swept.
+type Description = description.Description
+
+// Describer is implemented by Sweepables that can describe the resource they sweep.
+// Resources are described for dry runs, filtering and reporting.
+type Describer interface {
+	// Describe returns a description of the resource.
+	// If refresh is true, the resource's tags and creation time are also required.
+	Describe(ctx context.Context, refresh bool) (*Description, error)
+}
+
+// sweepOptions configure previewing and filtering the resources to be swept.
+type sweepOptions struct {
+	dryRun     bool
+	minAge     time.Duration
+	nameRegexp *regexp.Regexp
+	reportPath string
+	// tags maps tag keys to the required value. An empty value matches any value.
+	tags map[string]string
+}
+
+// options returns the sweep options configured via environment variables.
+var options = sync.OnceValues(sweepOptionsFromEnv)
+
+func sweepOptionsFromEnv() (*sweepOptions, error) {
+	opts := &sweepOptions{
+		reportPath: os.Getenv(envvar.SweepReportPath),
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		opts.dryRun = dryRun
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		opts.minAge = minAge
+	}
+
+	if v := os.Getenv(envvar.SweepNameRegex); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepNameRegex, err)
+		}
+		opts.nameRegexp = re
+	}
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		opts.tags = make(map[string]string)
+		for _, tag := range strings.Split(v, ",") {
+			k, v, _ := strings.Cut(strings.TrimSpace(tag), "=")
+			if k == "" {
+				return nil, fmt.Errorf("environment variable %s: empty tag key", envvar.SweepTags)
+			}
+			opts.tags[k] = v
+		}
+	}
+
+	return opts, nil
+}
+
+// filtering returns whether any resource filter is configured.
+func (o *sweepOptions) filtering() bool {
+	return o.nameRegexp != nil || o.needsRefresh()
+}
+
+// needsRefresh returns whether the configured resource filters require resources' tags or creation time.
+func (o *sweepOptions) needsRefresh() bool {
+	return len(o.tags) > 0 || o.minAge > 0
+}
+
+// describing returns whether resources must be described before they are swept.
+func (o *sweepOptions) describing() bool {
+	return o.dryRun || o.filtering() || o.reportPath != ""
+}
+
+// match returns whether the described resource is to be swept.
+// If not, the reason is returned.
+func (o *sweepOptions) match(desc *Description, now time.Time) (bool, string) {
+	if o.nameRegexp != nil && !o.nameRegexp.MatchString(desc.DisplayName()) {
+		return false, fmt.Sprintf("%q does not match %s", desc.DisplayName(), envvar.SweepNameRegex)
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(o.tags)) {
+		v, ok := desc.Tags[k]
+		if !ok {
+			return false, fmt.Sprintf("tag %q not found", k)
+		}
+		if want := o.tags[k]; want != "" && v != want {
+			return false, fmt.Sprintf("tag %q value %q does not match %q", k, v, want)
+		}
+	}
+
+	if o.minAge > 0 {
+		if desc.CreatedAt == nil {
+			return false, "creation time unknown"
+		}
+		if age := now.Sub(*desc.CreatedAt); age < o.minAge {
+			return false, fmt.Sprintf("created %s ago, less than %s", age.Truncate(time.Second), o.minAge)
+		}
+	}
+
+	return true, ""
+}
+
+// readOnlyOperationPrefixes are the name prefixes of AWS API operations that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"AssumeRole",
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// modifyAllowedKey is the context key marking AWS API operations made while SweepOrchestrator deletes a resource.
+type modifyAllowedKey struct{}
+
+// withModifyAllowed returns a context in which readOnlyAPIOption allows operations that may modify resources.
+func withModifyAllowed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, modifyAllowedKey{}, true)
+}
+
+// modifyAllowed returns whether operations that may modify resources are allowed in the context.
+func modifyAllowed(ctx context.Context) bool {
+	v, _ := ctx.Value(modifyAllowedKey{}).(bool)
+	return v
+}
+
+// readOnlyAPIOption is AWS API middleware failing any operation that may modify resources,
+// unless made while SweepOrchestrator deletes a resource matching the configured filters.
+// It is used in dry runs and when filtering to prevent sweepers that delete resources directly,
+// rather than via SweepOrchestrator, from deleting resources that have not been filtered.
+func readOnlyAPIOption(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SweepReadOnly", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		operation := awsmiddleware.GetOperationName(ctx)
+		if !modifyAllowed(ctx) && !slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool { return strings.HasPrefix(operation, prefix) }) {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("%s %s not allowed: in a dry run or when filtering, resources can only be deleted via SweepOrchestrator", awsmiddleware.GetServiceID(ctx), operation)
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
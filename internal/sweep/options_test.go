@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestSweepOptionsMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-1 * time.Hour)
+
+	testCases := map[string]struct {
+		options  sweepOptions
+		desc     Description
+		expected bool
+	}{
+		"no filters": {
+			desc: Description{
+				ID: "test",
+			},
+			expected: true,
+		},
+		"name matching": {
+			options: sweepOptions{
+				nameRegexp: regexp.MustCompile(`^tf-acc-test-`),
+			},
+			desc: Description{
+				ID:   "i-12345678",
+				Name: "tf-acc-test-123",
+			},
+			expected: true,
+		},
+		"name not matching": {
+			options: sweepOptions{
+				nameRegexp: regexp.MustCompile(`^tf-acc-test-`),
+			},
+			desc: Description{
+				ID:   "i-12345678",
+				Name: "production",
+			},
+			expected: false,
+		},
+		"ID matching": {
+			options: sweepOptions{
+				nameRegexp: regexp.MustCompile(`^tf-acc-test-`),
+			},
+			desc: Description{
+				ID: "tf-acc-test-123",
+			},
+			expected: true,
+		},
+		"tags matching": {
+			options: sweepOptions{
+				tags: map[string]string{
+					"Owner":     "test",
+					"Temporary": "",
+				},
+			},
+			desc: Description{
+				ID: "test",
+				Tags: map[string]string{
+					"Owner":     "test",
+					"Temporary": "yes",
+				},
+			},
+			expected: true,
+		},
+		"tag value not matching": {
+			options: sweepOptions{
+				tags: map[string]string{
+					"Owner": "test",
+				},
+			},
+			desc: Description{
+				ID: "test",
+				Tags: map[string]string{
+					"Owner": "platform",
+				},
+			},
+			expected: false,
+		},
+		"tag not found": {
+			options: sweepOptions{
+				tags: map[string]string{
+					"Temporary": "",
+				},
+			},
+			desc: Description{
+				ID: "test",
+			},
+			expected: false,
+		},
+		"old enough": {
+			options: sweepOptions{
+				minAge: 24 * time.Hour,
+			},
+			desc: Description{
+				ID:        "test",
+				CreatedAt: &old,
+			},
+			expected: true,
+		},
+		"too recent": {
+			options: sweepOptions{
+				minAge: 24 * time.Hour,
+			},
+			desc: Description{
+				ID:        "test",
+				CreatedAt: &recent,
+			},
+			expected: false,
+		},
+		"creation time unknown": {
+			options: sweepOptions{
+				minAge: 24 * time.Hour,
+			},
+			desc: Description{
+				ID: "test",
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.options.match(&testCase.desc, now)
+
+			if got != testCase.expected {
+				t.Errorf("match = %t (%s), want %t", got, reason, testCase.expected)
+			}
+			if !got && reason == "" {
+				t.Error("expected reason")
+			}
+		})
+	}
+}
+
+func TestReadOnlyAPIOption(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operation     string
+		modifyAllowed bool
+		expectError   bool
+	}{
+		"read-only": {
+			operation: "DescribeVpcs",
+		},
+		"modifying": {
+			operation:   "DeleteVpc",
+			expectError: true,
+		},
+		"modifying allowed": {
+			operation:     "DeleteVpc",
+			modifyAllowed: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.modifyAllowed {
+				ctx = withModifyAllowed(ctx)
+			}
+
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "EC2",
+				OperationName: testCase.operation,
+			}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := readOnlyAPIOption(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+
+			_, _, err := handler.Handle(ctx, struct{}{})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"log"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// sweepers are the registered sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// Sweepers run by TestMain are run in an order computed from their declared Dependencies.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already registered", name)
+	}
+
+	sweepers[name] = s
+
+	resource.AddTestSweepers(name, s)
+}
+
+// sweeperOrder returns the names of the sweepers to run, with each sweeper after the sweepers it depends on.
+// If filter is not empty, only the sweepers whose names contain any of the comma-separated filter values
+// and the sweepers they depend on are returned.
+// Returns an error if a dependency cycle is detected.
+func sweeperOrder(sweepers map[string]*resource.Sweeper, filter string) ([]string, error) {
+	g := depgraph.New()
+
+	// Sort the names so that the order is deterministic.
+	names := slices.Sorted(maps.Keys(sweepers))
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == "" {
+		return order, nil
+	}
+
+	selected := make(map[string]struct{})
+	for _, name := range names {
+		if !slices.ContainsFunc(strings.Split(strings.ToLower(filter), ","), func(v string) bool {
+			return strings.Contains(strings.ToLower(name), v)
+		}) {
+			continue
+		}
+
+		dependencies, err := g.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		selected[name] = struct{}{}
+		for _, dependency := range dependencies {
+			selected[dependency] = struct{}{}
+		}
+	}
+
+	return slices.DeleteFunc(order, func(name string) bool {
+		_, ok := selected[name]
+		return !ok
+	}), nil
+}
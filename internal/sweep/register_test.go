@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSweeperOrder(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_subnet": {
+			Dependencies: []string{"aws_instance"},
+		},
+		"aws_vpc": {
+			Dependencies: []string{"aws_internet_gateway", "aws_subnet"},
+		},
+		"aws_instance": {
+			Dependencies: []string{"aws_not_registered"},
+		},
+		"aws_internet_gateway": {},
+		"aws_sns_topic":        {},
+	}
+
+	testCases := map[string]struct {
+		sweepers    map[string]*resource.Sweeper
+		filter      string
+		expected    []string
+		expectError bool
+	}{
+		"empty": {
+			sweepers: map[string]*resource.Sweeper{},
+			expected: []string{},
+		},
+		"all": {
+			sweepers: sweepers,
+			expected: []string{"aws_sns_topic", "aws_internet_gateway", "aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		"filter": {
+			sweepers: sweepers,
+			filter:   "aws_subnet",
+			expected: []string{"aws_instance", "aws_subnet"},
+		},
+		"multiple filters": {
+			sweepers: sweepers,
+			filter:   "AWS_SNS,gateway",
+			expected: []string{"aws_sns_topic", "aws_internet_gateway"},
+		},
+		"no match": {
+			sweepers: sweepers,
+			filter:   "aws_s3",
+			expected: []string{},
+		},
+		"cycle": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": {
+					Dependencies: []string{"aws_b"},
+				},
+				"aws_b": {
+					Dependencies: []string{"aws_a"},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := sweeperOrder(testCase.sweepers, testCase.filter)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	resourceActionDeleted     = "deleted"
+	resourceActionFailed      = "failed"
+	resourceActionSkipped     = "skipped"
+	resourceActionWouldDelete = "would_delete"
+)
+
+// sweepReport is the machine-readable report of a sweep.
+type sweepReport struct {
+	DryRun    bool             `json:"dry_run"`
+	Regions   []string         `json:"regions"`
+	Order     []string         `json:"order"`
+	StartedAt time.Time        `json:"started_at"`
+	Sweepers  []*reportSweeper `json:"sweepers"`
+}
+
+// reportSweeper is the report of a single sweeper's run in a Region.
+type reportSweeper struct {
+	Name         string            `json:"name"`
+	Region       string            `json:"region"`
+	DurationMsec int64             `json:"duration_ms"`
+	Error        string            `json:"error,omitempty"`
+	Resources    []*reportResource `json:"resources"`
+
+	lock sync.Mutex
+}
+
+// reportResource is the report of a single resource handled by a sweeper.
+type reportResource struct {
+	*Description
+
+	Action string `json:"action"`
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// activeSweeper is the report of the sweeper currently being run, or nil if sweepers are not being run by runSweepers.
+var activeSweeper atomic.Pointer[reportSweeper]
+
+func (r *sweepReport) begin(name, region string) *reportSweeper {
+	sweeper := &reportSweeper{
+		Name:      name,
+		Region:    region,
+		Resources: make([]*reportResource, 0),
+	}
+	r.Sweepers = append(r.Sweepers, sweeper)
+	activeSweeper.Store(sweeper)
+
+	return sweeper
+}
+
+func (s *reportSweeper) end(duration time.Duration, err error) {
+	activeSweeper.CompareAndSwap(s, nil)
+
+	s.DurationMsec = duration.Milliseconds()
+	if err != nil {
+		s.Error = err.Error()
+	}
+}
+
+// add records a resource handled by the sweeper.
+// It does nothing if sweepers are not being run by runSweepers.
+func (s *reportSweeper) add(resource *reportResource) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.Resources = append(s.Resources, resource)
+}
+
+// write writes the report as JSON to the specified file.
+func (r *sweepReport) write(path string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0o600)
+}
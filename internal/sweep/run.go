@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestMain runs the registered sweepers if the -sweep flag is set; otherwise it runs the tests.
+// It supports the same flags as the testing framework's TestMain.
+func TestMain(m interface{ Run() int }) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	if err := runSweepers(strings.Split(regions, ","), flagValue("sweep-run"), flagValue("sweep-allow-failures") == "true"); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// flagValue returns the value of the specified command-line flag, or an empty string if the flag is not defined.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// runSweepers runs the registered sweepers matching the filter in each Region, in dependency order.
+// In a dry run all sweepers are run, regardless of failures.
+func runSweepers(regions []string, filter string, allowFailures bool) error {
+	opts, err := options()
+	if err != nil {
+		return err
+	}
+
+	order, err := sweeperOrder(sweepers, filter)
+	if err != nil {
+		return fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	report := &sweepReport{
+		DryRun:    opts.dryRun,
+		Regions:   regions,
+		Order:     order,
+		StartedAt: time.Now().UTC(),
+		Sweepers:  make([]*reportSweeper, 0),
+	}
+
+	if opts.dryRun {
+		log.Printf("[INFO] Dry run: no resources will be deleted")
+	}
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		log.Printf("[DEBUG] Running Sweepers for region (%s):\n", region)
+
+		for _, name := range order {
+			log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+			sweeper := report.begin(name, region)
+			start := time.Now()
+			err := sweepers[name].F(region)
+			sweeper.end(time.Since(start), err)
+
+			if err == nil {
+				continue
+			}
+
+			err = fmt.Errorf("running Sweeper (%s) in region (%s): %w", name, region, err)
+
+			if !allowFailures && !opts.dryRun {
+				logSummary(report)
+				return errors.Join(err, writeReport(report, opts.reportPath))
+			}
+
+			log.Printf("[ERROR] %s", err)
+		}
+	}
+
+	logSummary(report)
+
+	return writeReport(report, opts.reportPath)
+}
+
+// writeReport writes the report to the specified file, if any.
+func writeReport(report *sweepReport, path string) error {
+	if path == "" {
+		return nil
+	}
+
+	if err := report.write(path); err != nil {
+		return fmt.Errorf("writing sweep report (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+// logSummary logs the number of resources handled by each sweeper.
+func logSummary(report *sweepReport) {
+	for _, sweeper := range report.Sweepers {
+		counts := make(map[string]int)
+		for _, resource := range sweeper.Resources {
+			counts[resource.Action]++
+		}
+
+		status := "passed"
+		if sweeper.Error != "" {
+			status = "failed"
+		}
+
+		log.Printf("[INFO] Sweeper (%s) in region (%s) %s: %d deleted, %d would be deleted, %d skipped, %d failed",
+			sweeper.Name, sweeper.Region, status,
+			counts[resourceActionDeleted], counts[resourceActionWouldDelete], counts[resourceActionSkipped], counts[resourceActionFailed])
+	}
+}
@@ -16,8 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/description"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +93,70 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// creationTimeAttributes are the names of attributes commonly holding a resource's creation time.
+var creationTimeAttributes = []string{
+	names.AttrCreateTime,
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+}
+
+// Describe returns a description of the resource to be swept.
+// If refresh is true, the resource is first read so that its tags and creation time are available.
+func (sr *sweepResource) Describe(ctx context.Context, refresh bool) (*description.Description, error) {
+	if refresh {
+		// Resources using transparent tagging return their tags in Context.
+		ctx = tftags.NewContext(ctx, nil, nil)
+
+		if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+			return nil, err
+		}
+	}
+
+	desc := &description.Description{
+		ID: sr.d.Id(),
+	}
+
+	schema := sr.resource.SchemaMap()
+
+	if _, ok := schema[names.AttrName]; ok {
+		if v, ok := sr.d.Get(names.AttrName).(string); ok {
+			desc.Name = v
+		}
+	}
+
+	for _, attr := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := schema[attr]; ok {
+			if v, ok := sr.d.Get(attr).(map[string]any); ok && len(v) > 0 {
+				desc.Tags = flex.ExpandStringValueMap(v)
+				break
+			}
+		}
+	}
+	if desc.Tags == nil {
+		if inContext, ok := tftags.FromContext(ctx); ok {
+			if tags, err := inContext.TagsOut.Unwrap(); err == nil {
+				desc.Tags = tags.Map()
+			}
+		}
+	}
+
+	for _, attr := range creationTimeAttributes {
+		if _, ok := schema[attr]; ok {
+			if v, ok := sr.d.Get(attr).(string); ok {
+				if t, err := time.Parse(time.RFC3339, v); err == nil {
+					desc.CreatedAt = &t
+					break
+				}
+			}
+		}
+	}
+
+	return desc, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -65,6 +65,16 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		SuppressDebugLog: true,
 	}
 
+	opts, err := options()
+	if err != nil {
+		return nil, err
+	}
+
+	// In a dry run or when filtering, AWS API operations that may modify resources fail unless made by SweepOrchestrator.
+	if opts.dryRun || opts.filtering() {
+		conf.APIOptions = append(conf.APIOptions, readOnlyAPIOption)
+	}
+
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
 		ar := awsbase.AssumeRole{
 			RoleARN:  role,
@@ -106,20 +116,99 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources concurrently.
+// Resources not matching any configured filters are skipped, and in a dry run no resources are deleted.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	opts, err := options()
+	if err != nil {
+		return err
+	}
+
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	sweeper := activeSweeper.Load()
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			if !opts.describing() {
+				return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			}
+
+			return sweepDescribed(ctx, opts, sweeper, sweepable, optFns...)
 		})
 	}
 
 	return g.Wait().ErrorOrNil()
 }
 
+// sweepDescribed describes the resource, then deletes it if it matches the configured filters and this is not a dry run.
+func sweepDescribed(ctx context.Context, opts *sweepOptions, sweeper *reportSweeper, sweepable Sweepable, optFns ...tfresource.OptionsFunc) error {
+	var desc *Description
+
+	if v, ok := sweepable.(Describer); ok {
+		var err error
+		desc, err = v.Describe(ctx, opts.needsRefresh())
+
+		if err != nil {
+			sweeper.add(&reportResource{
+				Action: resourceActionFailed,
+				Error:  err.Error(),
+			})
+
+			return fmt.Errorf("describing resource: %w", err)
+		}
+
+		ctx = tflog.SetField(ctx, "sweep_resource_id", desc.ID)
+	}
+
+	item := &reportResource{
+		Description: desc,
+	}
+
+	var skipReason string
+	switch {
+	case desc == nil && opts.filtering():
+		skipReason = "resource cannot be described"
+	case desc != nil && desc.ID == "":
+		skipReason = "resource not found"
+	case desc != nil && opts.filtering():
+		if ok, reason := opts.match(desc, time.Now()); !ok {
+			skipReason = reason
+		}
+	}
+
+	if skipReason != "" {
+		tflog.Debug(ctx, "Skipping resource", map[string]any{
+			"reason": skipReason,
+		})
+		item.Action, item.Reason = resourceActionSkipped, skipReason
+		sweeper.add(item)
+
+		return nil
+	}
+
+	if opts.dryRun {
+		tflog.Info(ctx, "Would sweep resource")
+		item.Action = resourceActionWouldDelete
+		sweeper.add(item)
+
+		return nil
+	}
+
+	if err := sweepable.Delete(withModifyAllowed(ctx), ThrottlingRetryTimeout, optFns...); err != nil {
+		item.Action, item.Error = resourceActionFailed, err.Error()
+		sweeper.add(item)
+
+		return err
+	}
+
+	item.Action = resourceActionDeleted
+	sweeper.add(item)
+
+	return nil
+}
+
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
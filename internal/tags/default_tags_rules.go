@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DefaultTagsRule contains tags to default across the resource types matching the rule.
+type DefaultTagsRule struct {
+	Name string
+	Tags KeyValueTags
+	// Services are the provider service package names to which the rule applies. An empty list matches all services.
+	Services []string
+	// IncludeResourceTypes are resource type name patterns, e.g. `aws_s3_*`, to which the rule applies.
+	// An empty list matches all resource types.
+	IncludeResourceTypes []string
+	// ExcludeResourceTypes are resource type name patterns to which the rule does not apply.
+	// Exclusions take precedence over inclusions.
+	ExcludeResourceTypes []string
+}
+
+// Matches returns true if the rule applies to the specified resource type;
+// otherwise returns false.
+func (r *DefaultTagsRule) Matches(servicePackageName, typeName string) bool {
+	if len(r.Services) > 0 && !slices.Contains(r.Services, servicePackageName) {
+		return false
+	}
+
+	if len(r.IncludeResourceTypes) > 0 && !matchesAnyResourceType(r.IncludeResourceTypes, typeName) {
+		return false
+	}
+
+	return !matchesAnyResourceType(r.ExcludeResourceTypes, typeName)
+}
+
+// matchesAnyResourceType returns true if the resource type name matches any of the glob patterns.
+func matchesAnyResourceType(patterns []string, typeName string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		return globMatch(pattern, typeName)
+	})
+}
+
+// ForResourceType returns the default tags configuration in effect for the specified resource type.
+// Tags from each matching rule are merged, in order, on to the configuration's Tags, so later rules take precedence.
+// The returned configuration has no rules and records the rule that contributed each tag whose value differs from the configuration's Tags.
+func (dc *DefaultConfig) ForResourceType(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	result := &DefaultConfig{
+		Tags:    make(KeyValueTags),
+		sources: make(map[string]string),
+	}
+
+	for k, v := range dc.Tags {
+		result.Tags[k] = v
+	}
+
+	for _, rule := range dc.Rules {
+		if !rule.Matches(servicePackageName, typeName) {
+			continue
+		}
+
+		for k, v := range rule.Tags {
+			result.Tags[k] = v
+
+			// A rule that repeats an unscoped default tag does not change it.
+			if unscoped, ok := dc.Tags[k]; ok && unscoped.Equal(v) {
+				delete(result.sources, k)
+			} else {
+				result.sources[k] = rule.Name
+			}
+		}
+	}
+
+	return result
+}
+
+// TagSource returns the name of the rule that contributed the default tag with the specified key,
+// or an empty string if the tag has the value from the configuration's Tags.
+func (dc *DefaultConfig) TagSource(key string) string {
+	if dc == nil {
+		return ""
+	}
+
+	return dc.sources[key]
+}
+
+// RuleTagSources describes the default tags contributed by rules and merged on to the specified resource tags,
+// e.g. `Owner (rule "storage")`, or returns an empty string if no rule contributed a tag.
+// Tags from the configuration's Tags and tags overridden by the resource's own tags are not described.
+func (dc *DefaultConfig) RuleTagSources(resourceTags KeyValueTags) string {
+	keys := dc.GetTags().Keys()
+	slices.Sort(keys)
+
+	var sources []string
+	for _, k := range keys {
+		if _, ok := resourceTags[k]; ok {
+			continue
+		}
+
+		if v := dc.TagSource(k); v != "" {
+			sources = append(sources, fmt.Sprintf("%s (rule %q)", k, v))
+		}
+	}
+
+	return strings.Join(sources, ", ")
+}
@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultTagsRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		rule               DefaultTagsRule
+		servicePackageName string
+		typeName           string
+		want               bool
+	}{
+		{
+			name:               "empty rule",
+			rule:               DefaultTagsRule{},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               true,
+		},
+		{
+			name: "service matching",
+			rule: DefaultTagsRule{
+				Services: []string{"ec2", "s3"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               true,
+		},
+		{
+			name: "service not matching",
+			rule: DefaultTagsRule{
+				Services: []string{"ec2"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               false,
+		},
+		{
+			name: "include matching",
+			rule: DefaultTagsRule{
+				IncludeResourceTypes: []string{"aws_instance", "aws_s3_*"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               true,
+		},
+		{
+			name: "include not matching",
+			rule: DefaultTagsRule{
+				IncludeResourceTypes: []string{"aws_instance"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               false,
+		},
+		{
+			name: "exclude matching",
+			rule: DefaultTagsRule{
+				IncludeResourceTypes: []string{"aws_s3_*"},
+				ExcludeResourceTypes: []string{"aws_s3_bucket"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               false,
+		},
+		{
+			name: "exclude not matching",
+			rule: DefaultTagsRule{
+				ExcludeResourceTypes: []string{"aws_s3_bucket_*"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               true,
+		},
+		{
+			name: "bracket matches itself",
+			rule: DefaultTagsRule{
+				IncludeResourceTypes: []string{"aws_s3_["},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               false,
+		},
+		{
+			name: "wildcard matches any character",
+			rule: DefaultTagsRule{
+				IncludeResourceTypes: []string{"aws_s3_buck?t"},
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.rule.Matches(testCase.servicePackageName, testCase.typeName)
+
+			if got != testCase.want {
+				t.Errorf("got %t; want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Environment": "dev",
+			"Owner":       "platform",
+		}),
+		Rules: []DefaultTagsRule{
+			{
+				Name: "storage",
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+					"Owner":  "storage",
+				}),
+				IncludeResourceTypes: []string{"aws_s3_bucket", "aws_ebs_*"},
+			},
+			{
+				Name: "compliance",
+				Tags: New(ctx, map[string]string{
+					"Backup":     "hourly",
+					"Compliance": "pci",
+				}),
+				Services: []string{"s3"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		wantTags           map[string]string
+		wantSources        map[string]string
+	}{
+		{
+			name:               "nil config",
+			defaultConfig:      nil,
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+		},
+		{
+			name: "no rules",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Environment": "dev",
+				}),
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			wantTags: map[string]string{
+				"Environment": "dev",
+			},
+		},
+		{
+			name:               "no matching rules",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			wantTags: map[string]string{
+				"Environment": "dev",
+				"Owner":       "platform",
+			},
+		},
+		{
+			name:               "one matching rule",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_ebs_volume",
+			wantTags: map[string]string{
+				"Backup":      "daily",
+				"Environment": "dev",
+				"Owner":       "storage",
+			},
+			wantSources: map[string]string{
+				"Backup": "storage",
+				"Owner":  "storage",
+			},
+		},
+		{
+			name:               "multiple matching rules",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			wantTags: map[string]string{
+				"Backup":      "hourly",
+				"Compliance":  "pci",
+				"Environment": "dev",
+				"Owner":       "storage",
+			},
+			wantSources: map[string]string{
+				"Backup":     "compliance",
+				"Compliance": "compliance",
+				"Owner":      "storage",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.servicePackageName, testCase.typeName)
+
+			if testCase.wantTags == nil {
+				if got != nil {
+					t.Fatalf("got %v; want nil", got)
+				}
+				return
+			}
+
+			if got := got.GetTags(); !got.Equal(New(ctx, testCase.wantTags)) {
+				t.Errorf("got tags %s; want %v", got, testCase.wantTags)
+			}
+
+			if len(got.Rules) > 0 {
+				t.Errorf("got %d rules; want none", len(got.Rules))
+			}
+
+			for k := range testCase.wantTags {
+				if got, want := got.TagSource(k), testCase.wantSources[k]; got != want {
+					t.Errorf("got source %q for %q; want %q", got, k, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDefaultConfigRuleTagSources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	defaultConfig := (&DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Environment": "dev",
+			"Owner":       "platform",
+		}),
+		Rules: []DefaultTagsRule{
+			{
+				Name: "storage",
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+					"Owner":  "storage",
+				}),
+				Services: []string{"s3"},
+			},
+			{
+				Name: "unchanged",
+				Tags: New(ctx, map[string]string{
+					"Environment": "dev",
+				}),
+			},
+		},
+	}).ForResourceType("s3", "aws_s3_bucket")
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceTags  map[string]string
+		want          string
+	}{
+		{
+			name: "no rules",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Environment": "dev",
+				}),
+			},
+		},
+		{
+			name:          "rule tags, excluding unchanged unscoped tags",
+			defaultConfig: defaultConfig,
+			want:          `Backup (rule "storage"), Owner (rule "storage")`,
+		},
+		{
+			name:          "rule tag overridden by resource tag",
+			defaultConfig: defaultConfig,
+			resourceTags: map[string]string{
+				"Owner": "team",
+			},
+			want: `Backup (rule "storage")`,
+		},
+		{
+			name:          "all rule tags overridden by resource tags",
+			defaultConfig: defaultConfig,
+			resourceTags: map[string]string{
+				"Backup": "none",
+				"Owner":  "team",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.defaultConfig.RuleTagSources(New(ctx, testCase.resourceTags)), testCase.want; got != want {
+				t.Errorf("got %q; want %q", got, want)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -47,17 +48,79 @@ const (
 	// provider configuration. When multiple key prefixes are provided, the values are
 	// comma-separated.
 	IgnoreTagsKeyPrefixesEnvVar = "TF_AWS_IGNORE_TAGS_KEY_PREFIXES"
+
+	// Environment variable disabling any provider configured deletion guard
+	//
+	// Intended for deliberate teardown of protected resources. Any value that
+	// parses as a true boolean, e.g. `true` or `1`, disables the guard.
+	DeletionGuardDisabledEnvVar = "TF_AWS_DELETION_GUARD_DISABLED"
 )
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// Rules contain tags to default across the resource types matching each rule.
+	// Use ForResourceType to resolve the tags in effect for a resource type.
+	Rules []DefaultTagsRule
+
+	// sources maps tag keys to the name of the rule that contributed the tag.
+	sources map[string]string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	// KeyPatterns are glob patterns matching the keys of tags to remove.
+	KeyPatterns []string
+	// KeyRegexps are regular expressions matching the keys of tags to remove.
+	KeyRegexps []*regexp.Regexp
+	// TagPatterns match the keys and values of tags to remove.
+	TagPatterns []IgnoreTagPattern
+}
+
+// IgnoreTagPattern contains glob patterns matching both the key and the value of a tag.
+type IgnoreTagPattern struct {
+	Key   string
+	Value string
+}
+
+// DeletionGuardConfig contains the tag identifying resources that must not be deleted.
+type DeletionGuardConfig struct {
+	TagKey string
+	// TagValue is the tag value to match. An empty value matches any value.
+	TagValue string
+}
+
+// Protects returns true if the given tags match the configuration's tag;
+// otherwise returns false
+func (dg *DeletionGuardConfig) Protects(tags KeyValueTags) bool {
+	if dg == nil || dg.TagKey == "" {
+		return false
+	}
+
+	if !tags.KeyExists(dg.TagKey) {
+		return false
+	}
+
+	if dg.TagValue == "" {
+		return true
+	}
+
+	v := tags.KeyValue(dg.TagKey)
+
+	return v != nil && *v == dg.TagValue
+}
+
+// ErrorDetail returns the detail of the diagnostic reported when deletion of a protected resource is prevented.
+func (dg *DeletionGuardConfig) ErrorDetail() string {
+	tag := dg.TagKey
+	if v := dg.TagValue; v != "" {
+		tag += "=" + v
+	}
+
+	return fmt.Sprintf("The resource is tagged %q, matching the provider's deletion_guard configuration.\n\n"+
+		"To delete the resource, remove the tag or set the %s environment variable to \"true\".", tag, DeletionGuardDisabledEnvVar)
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -127,6 +190,16 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
 
+	if len(config.KeyPatterns) > 0 {
+		result = result.IgnoreKeyPatterns(config.KeyPatterns)
+	}
+	if len(config.KeyRegexps) > 0 {
+		result = result.IgnoreKeyRegexps(config.KeyRegexps)
+	}
+	if len(config.TagPatterns) > 0 {
+		result = result.IgnoreTagPatterns(config.TagPatterns)
+	}
+
 	return result
 }
 
@@ -177,6 +250,87 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreKeyPatterns returns tag keys not matching any glob pattern.
+func (tags KeyValueTags) IgnoreKeyPatterns(patterns []string) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(patterns, func(pattern string) bool { return globMatch(pattern, k) }) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreKeyRegexps returns tag keys not matching any regular expression.
+func (tags KeyValueTags) IgnoreKeyRegexps(regexps []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(regexps, func(re *regexp.Regexp) bool { return re.MatchString(k) }) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreTagPatterns returns tags whose key and value do not both match any pattern.
+func (tags KeyValueTags) IgnoreTagPatterns(patterns []IgnoreTagPattern) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(patterns, func(pattern IgnoreTagPattern) bool {
+			return globMatch(pattern.Key, k) && globMatch(pattern.Value, v.ValueString())
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// globMatch returns true if s matches the glob pattern; otherwise returns false.
+// In the pattern `*` matches any sequence of characters, including `/` and `:`, and `?` matches any single character.
+// All other characters match themselves, so every pattern is valid.
+// This is the only glob syntax used for tags, e.g. by default_tags rules and ignore_tags patterns.
+func globMatch(pattern, s string) bool {
+	p, r := []rune(pattern), []rune(s)
+	i, j := 0, 0
+	// Position of the last `*` in the pattern and the position in s up to which it matches.
+	star, match := -1, 0
+
+	for j < len(r) {
+		switch {
+		case i < len(p) && (p[i] == '?' || p[i] == r[j]):
+			i++
+			j++
+		case i < len(p) && p[i] == '*':
+			star, match = i, j
+			i++
+		case star != -1:
+			// Backtrack, extending the match of the last `*` by one character.
+			match++
+			i, j = star+1, match
+		default:
+			return false
+		}
+	}
+
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+
+	return i == len(p)
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -5,6 +5,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -314,6 +315,98 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDeletionGuardConfigProtects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name                string
+		tags                KeyValueTags
+		deletionGuardConfig *DeletionGuardConfig
+		want                bool
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"protect": "true",
+			}),
+			deletionGuardConfig: nil,
+			want:                false,
+		},
+		{
+			name: "empty config",
+			tags: New(ctx, map[string]string{
+				"protect": "true",
+			}),
+			deletionGuardConfig: &DeletionGuardConfig{},
+			want:                false,
+		},
+		{
+			name: "no tags",
+			tags: nil,
+			deletionGuardConfig: &DeletionGuardConfig{
+				TagKey: "protect",
+			},
+			want: false,
+		},
+		{
+			name: "key not matching",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			deletionGuardConfig: &DeletionGuardConfig{
+				TagKey: "protect",
+			},
+			want: false,
+		},
+		{
+			name: "key matching any value",
+			tags: New(ctx, map[string]string{
+				"key1":    "value1",
+				"protect": "",
+			}),
+			deletionGuardConfig: &DeletionGuardConfig{
+				TagKey: "protect",
+			},
+			want: true,
+		},
+		{
+			name: "key and value matching",
+			tags: New(ctx, map[string]string{
+				"protect": "true",
+			}),
+			deletionGuardConfig: &DeletionGuardConfig{
+				TagKey:   "protect",
+				TagValue: "true",
+			},
+			want: true,
+		},
+		{
+			name: "only key matching",
+			tags: New(ctx, map[string]string{
+				"protect": "false",
+			}),
+			deletionGuardConfig: &DeletionGuardConfig{
+				TagKey:   "protect",
+				TagValue: "true",
+			},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.deletionGuardConfig.Protects(testCase.tags)
+
+			if got != testCase.want {
+				t.Errorf("got %t; want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -564,6 +657,71 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key patterns",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/prod":     "owned",
+				"kubernetes.io/cluster/staging":  "shared",
+				"kubernetes.io/role/elb":         "1",
+				"aws:cloudformation:stack-name":  "stack",
+				"aws:cloudformation:logical-id":  "Bucket",
+				"custom:cloudformation:stack-id": "stack",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []string{
+					"kubernetes.io/cluster/*",
+					"aws:cloudformation:*",
+				},
+			},
+			want: map[string]string{
+				"kubernetes.io/role/elb":         "1",
+				"custom:cloudformation:stack-id": "stack",
+			},
+		},
+		{
+			name: "key regexps",
+			tags: New(ctx, map[string]string{
+				"team-1234":   "value1",
+				"team-abcd":   "value2",
+				"owner-1234":  "value3",
+				"team-1234-x": "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexps: []*regexp.Regexp{
+					regexp.MustCompile(`^team-[0-9]+$`),
+				},
+			},
+			want: map[string]string{
+				"team-abcd":   "value2",
+				"owner-1234":  "value3",
+				"team-1234-x": "value4",
+			},
+		},
+		{
+			name: "tag patterns",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/prod":    "owned",
+				"kubernetes.io/cluster/staging": "shared",
+				"created-by":                    "karpenter-v1",
+				"owner":                         "karpenter-v1",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				TagPatterns: []IgnoreTagPattern{
+					{
+						Key:   "kubernetes.io/cluster/*",
+						Value: "owned",
+					},
+					{
+						Key:   "created-by",
+						Value: "karpenter*",
+					},
+				},
+			},
+			want: map[string]string{
+				"kubernetes.io/cluster/staging": "shared",
+				"owner":                         "karpenter-v1",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -577,6 +735,43 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestGlobMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{pattern: "", s: "", want: true},
+		{pattern: "", s: "key", want: false},
+		{pattern: "*", s: "", want: true},
+		{pattern: "*", s: "kubernetes.io/cluster/prod", want: true},
+		{pattern: "key", s: "key", want: true},
+		{pattern: "key", s: "key1", want: false},
+		{pattern: "key?", s: "key1", want: true},
+		{pattern: "key?", s: "key", want: false},
+		{pattern: "kubernetes.io/cluster/*", s: "kubernetes.io/cluster/prod", want: true},
+		{pattern: "kubernetes.io/cluster/*", s: "kubernetes.io/role/elb", want: false},
+		{pattern: "aws:*:stack-*", s: "aws:cloudformation:stack-name", want: true},
+		{pattern: "aws:*:stack-*", s: "aws:cloudformation:logical-id", want: false},
+		{pattern: "*-id", s: "aws:cloudformation:stack-id", want: true},
+		{pattern: "a*b*c", s: "aXbYbZc", want: true},
+		{pattern: "a*b*c", s: "aXbYbZ", want: false},
+		{pattern: "ключ*", s: "ключ1", want: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern+"/"+testCase.s, func(t *testing.T) {
+			t.Parallel()
+
+			if got := globMatch(testCase.pattern, testCase.s); got != testCase.want {
+				t.Errorf("got %t; want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	t.Parallel()
 
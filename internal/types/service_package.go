@@ -58,3 +58,14 @@ type ServicePackageSDKResource struct {
 	Name     string
 	Tags     *ServicePackageResourceTags
 }
+
+// ServicePackageSDKResourceStateMover represents a move of the state of a resource to
+// a Terraform Plugin SDK resource implemented by a service package, as used by `moved` blocks.
+type ServicePackageSDKResourceStateMover struct {
+	SourceTypeName      string
+	SourceSchemaVersion int
+	TargetTypeName      string
+	// StateMover returns the target resource's state from the source resource's state.
+	// States are JSON-decoded maps of attribute values. Attributes missing from the target state are null.
+	StateMover func(context.Context, map[string]any) (map[string]any, error)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -28,6 +29,7 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when there is a known diff (excluding an empty map)
@@ -42,6 +44,14 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	// Show which default_tags rules contributed tags whenever the planned tags change.
+	// Warnings are returned by the provider server as CustomizeDiff functions can only return errors.
+	if v := defaultTagsConfig.RuleTagSources(resourceTags); v != "" {
+		if o, _ := diff.GetChange("tags_all"); !tftags.New(ctx, o).Equal(allTags) {
+			sdkdiag.AddWarningToContext(ctx, "Default tags applied by default_tags rules", v)
+		}
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
@@ -10,6 +10,7 @@ import (
 	"runtime/debug"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -44,6 +45,10 @@ func main() {
 		serveOpts...,
 	)
 
+	if err := conns.WriteAPICallSummary(); err != nil {
+		log.Printf("[ERROR] writing AWS API call summary: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -7162,11 +7162,12 @@ service "resourcegroupstaggingapi" {
   }
 
   resource_prefix {
+    actual  = "aws_(resourcegroupstaggingapi_|resource_tags)"
     correct = "aws_resourcegroupstaggingapi_"
   }
 
   provider_package_correct = "resourcegroupstaggingapi"
-  doc_prefix               = ["resourcegroupstaggingapi_"]
+  doc_prefix               = ["resourcegroupstaggingapi_", "resource_tags"]
   brand                    = "AWS"
 }
 
@@ -160,6 +160,30 @@ func PartitionForRegion(region string) endpoints.Partition {
 	return PartitionForRegion(endpoints.UsEast1RegionID)
 }
 
+// globalServices are the service packages whose resources are not bound to an AWS Region.
+var globalServices = map[string]struct{}{
+	Account:             {},
+	Budgets:             {},
+	CE:                  {},
+	CloudFront:          {},
+	CostOptimizationHub: {},
+	CUR:                 {},
+	GlobalAccelerator:   {},
+	IAM:                 {},
+	Organizations:       {},
+	Route53:             {},
+	Route53Domains:      {},
+	Shield:              {},
+	WAF:                 {},
+}
+
+// IsGlobalService returns whether the specified service package's resources are global,
+// i.e. they cannot be managed in an AWS Region other than the service's own.
+func IsGlobalService(service string) bool {
+	_, ok := globalServices[service]
+	return ok
+}
+
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {